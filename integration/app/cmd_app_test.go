@@ -76,21 +76,13 @@ func TestGenerateAnAppWithNoDefaultModuleAndCreateAModule(t *testing.T) {
 }
 
 func TestGenerateAnAppWithWasm(t *testing.T) {
-	t.Skip()
-
 	var (
 		env  = envtest.New(t)
 		path = env.Scaffold("blog")
 	)
 
-	env.Must(env.Exec("add Wasm module",
-		step.NewSteps(step.New(
-			step.Exec("starport", "s", "wasm"),
-			step.Workdir(path),
-		)),
-	))
-
-	env.Must(env.Exec("should not add Wasm module second time",
+	// The scaffolded apps use a version of the Cosmos SDK older than the one required by wasmd
+	env.Must(env.Exec("should prevent adding Wasm module to an app using an older Cosmos SDK",
 		step.NewSteps(step.New(
 			step.Exec("starport", "s", "wasm"),
 			step.Workdir(path),
@@ -201,5 +193,35 @@ func TestGenerateAStargateAppWithEmptyModule(t *testing.T) {
 		envtest.ExecShouldError(),
 	))
 
+	env.Must(env.Exec("add params to a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "params", "name", "isLaunched:bool", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("add params with constraints to a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "params", "minLaunch:uint{min=1}", "title:string{max=8}", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent adding an existing param",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "params", "name", "--module", "example"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent adding params to a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "params", "name", "--module", "nomodule"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
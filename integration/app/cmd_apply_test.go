@@ -0,0 +1,104 @@
+//go:build !relayer
+// +build !relayer
+
+package app_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+const schema = `
+modules:
+  - name: blog
+    types:
+      - name: post
+        kind: list
+        fields: [title, body]
+      - name: category
+        kind: map
+        indexes: [slug]
+        fields: [description]
+      - name: settings
+        kind: single
+        fields: [maxPosts:uint]
+    messages:
+      - name: vote
+        fields: [postID:uint]
+        response: [votes:uint]
+    queries:
+      - name: posts-by-category
+        request: [category]
+        response: [ids:array.uint]
+        paginated: true
+  - name: feed
+    ibc: true
+    dependencies: [bank]
+    packets:
+      - name: share
+        fields: [postID:uint]
+        ack: [received:bool]
+`
+
+func TestGenerateAnAppFromSchema(t *testing.T) {
+	var (
+		env        = envtest.New(t)
+		path       = env.Scaffold("blog")
+		schemaPath = filepath.Join(t.TempDir(), "schema.yml")
+	)
+
+	require.NoError(t, os.WriteFile(schemaPath, []byte(schema), 0644))
+
+	env.Must(env.Exec("apply a schema",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "apply", schemaPath),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("apply an unchanged schema",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "apply", schemaPath),
+			step.Workdir(path),
+		)),
+	))
+
+	extended := schema + `
+  - name: archive
+    types:
+      - name: entry
+        kind: list
+        fields: [postID:uint]
+`
+	require.NoError(t, os.WriteFile(schemaPath, []byte(extended), 0644))
+
+	env.Must(env.Exec("apply a schema with a new module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "apply", schemaPath),
+			step.Workdir(path),
+		)),
+	))
+
+	require.NoError(t, os.WriteFile(schemaPath, []byte(`
+modules:
+  - name: blog
+    types:
+      - name: post
+        kind: tree
+`), 0644))
+
+	env.Must(env.Exec("should prevent applying a schema with an invalid kind",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "apply", schemaPath),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
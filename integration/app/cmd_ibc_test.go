@@ -102,6 +102,29 @@ func TestCreateModuleWithIBC(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a module with a transfer middleware",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"module",
+				"swap",
+				"--ibc-middleware",
+				"transfer",
+				"--require-registration",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a module with an unsupported middleware",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "bar", "--ibc-middleware", "foo"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
 
@@ -149,6 +172,21 @@ func TestCreateIBCOracle(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create an oracle integration with a custom oracle module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "oracle", "oraclethree", "--module", "foo", "--provider", "custom"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an oracle with an unknown provider",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "oracle", "invalidOracle", "--module", "foo", "--provider", "unknown"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.Must(env.Exec("should prevent creating a BandChain oracle with no module specified",
 		step.NewSteps(step.New(
 			step.Exec("starport", "s", "band", "invalidOracle"),
@@ -292,6 +330,21 @@ func TestCreateIBCPacket(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a packet with timeouts and retries",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "packet", "retried", "text", "--module", "foo", "--retry", "3", "--timeout-height", "100"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a packet without timeout",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "packet", "notimeout", "text", "--module", "foo", "--timeout-duration", "0"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.Must(env.Exec("create a non-IBC module",
 		step.NewSteps(step.New(
 			step.Exec("starport", "s", "module", "bar", "--require-registration"),
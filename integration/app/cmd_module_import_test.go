@@ -0,0 +1,51 @@
+//go:build !relayer
+// +build !relayer
+
+package app_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestImportModuleWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("import a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "import", "github.com/cosmos/cosmos-sdk/x/authz/module"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent importing a module already imported",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "import", "github.com/cosmos/cosmos-sdk/x/authz/module"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent importing a package which isn't a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "import", "github.com/cosmos/cosmos-sdk/x/authz"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent importing a package of the app",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "import", "github.com/test/blog/x/blog"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
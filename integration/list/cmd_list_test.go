@@ -4,9 +4,11 @@
 package list_test
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/starport/integration"
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 )
@@ -24,6 +26,16 @@ func TestGenerateAnAppWithStargateWithListAndVerify(t *testing.T) {
 		)),
 	))
 
+	// The default access only authorizes the owner of a value and doesn't generate the ownership transfer
+	msgServer, err := os.ReadFile(filepath.Join(path, "x", "blog", "keeper", "msg_server_user.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(msgServer), "if msg.Creator != val.Creator {")
+	require.NotContains(t, string(msgServer), "IsAdmin")
+	require.NoFileExists(t, filepath.Join(path, "x", "blog", "keeper", "msg_server_user_ownership.go"))
+	txProto, err := os.ReadFile(filepath.Join(path, "proto", "blog", "tx.proto"))
+	require.NoError(t, err)
+	require.NotContains(t, string(txProto), "MsgTransferUserOwnership")
+
 	env.Must(env.Exec("create a list with custom path",
 		step.NewSteps(step.New(
 			step.Exec("starport", "s", "list", "AppPath", "email", "--path", "blog"),
@@ -115,6 +127,95 @@ func TestGenerateAnAppWithStargateWithListAndVerify(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a list with transferable ownership",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "ticket", "seat", "--access", "owner"),
+			step.Workdir(path),
+		)),
+	))
+	require.FileExists(t, filepath.Join(path, "x", "blog", "keeper", "msg_server_ticket_ownership.go"))
+
+	env.Must(env.Exec("create a list managed by the admins of the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "article", "title", "--access", "admin"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list open to any account",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "note", "body", "--access", "open"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a list with an invalid access",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "comment", "body", "--access", "root"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent setting the access of a list without messages",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "comment", "body", "--access", "admin", "--no-message"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	require.NoError(t, os.WriteFile(filepath.Join(path, "proto", "blog", "shared.proto"), []byte(`syntax = "proto3";
+package test.blog.blog;
+
+option go_package = "github.com/test/blog/x/blog/types";
+
+import "cosmos/base/v1beta1/coin.proto";
+
+message Book {
+  uint64 id = 1;
+  string title = 2;
+  repeated string authors = 3;
+  uint64 page_count = 4;
+  string creator = 5;
+}
+
+message Rating {
+  uint64 id = 1;
+  double score = 2;
+  string creator = 3;
+}
+
+message Prize {
+  uint64 id = 1;
+  cosmos.base.v1beta1.Coin amount = 2;
+  string creator = 3;
+}
+`), 0644))
+
+	env.Must(env.Exec("create a list from an existing proto message",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "book", "--from-proto", "proto/blog/shared.proto:Book"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a list from a proto message with an unsupported type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "rating", "--from-proto", "proto/blog/shared.proto:Rating"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating a list from a proto message with a nullable coin",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "prize", "--from-proto", "proto/blog/shared.proto:Prize"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
 
@@ -163,3 +264,55 @@ func TestCreateListInCustomModuleWithStargate(t *testing.T) {
 
 	env.EnsureAppIsSteady(path)
 }
+
+func TestCreateListWithCustomTypeArraysWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a custom type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "type", "price", "amount:uint", "denom"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a custom type with a nested custom type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "type", "line-item", "name", "quantity:uint", "price:Price"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list with an array of custom types",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "order", "buyer", "items:array.LineItem"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map with an array of custom types",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "cart", "items:array.LineItem", "--index", "owner"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a message with an array of custom types",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "place-order", "items:array.LineItem"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a list with an array of a non existent type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "shipment", "items:array.Unknown"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
@@ -4,9 +4,11 @@
 package map_test
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/starport/integration"
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 )
@@ -117,6 +119,25 @@ func TestCreateMapWithStargate(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a map managed by the admins of the module",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"map",
+				"map_with_admins",
+				"email",
+				"--index",
+				"foo:string,bar:uint",
+				"--module",
+				"example",
+				"--access",
+				"admin",
+			),
+			step.Workdir(path),
+		)),
+	))
+
 	env.Must(env.Exec("create a map with invalid index",
 		step.NewSteps(step.New(
 			step.Exec(
@@ -159,5 +180,94 @@ func TestCreateMapWithStargate(t *testing.T) {
 		envtest.ExecShouldError(),
 	))
 
+	require.NoError(t, os.WriteFile(filepath.Join(path, "proto", "blog", "shared.proto"), []byte(`syntax = "proto3";
+package test.blog.blog;
+
+option go_package = "github.com/test/blog/x/blog/types";
+
+message Shelf {
+  string code = 1;
+  uint64 capacity = 2;
+  string creator = 3;
+}
+`), 0644))
+
+	env.Must(env.Exec("create a map from an existing proto message",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "shelf", "--index", "code", "--from-proto", "proto/blog/shared.proto:Shelf"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a map not named after the proto message",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "rack", "--index", "code", "--from-proto", "proto/blog/shared.proto:Shelf"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
+
+func TestCreateSecondaryIndexesWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a list with a secondary index",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "post", "title", "author", "--secondary-index", "author"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map with secondary indexes",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "profile", "name", "country", "--index", "handle", "--secondary-index", "country,name"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a secondary index on a non existent field",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "note", "body", "--secondary-index", "missing"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating a secondary index on an index of the map",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "seat", "price:uint", "--index", "row", "--secondary-index", "row"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
+
+func TestCreateMapWithCompositeIndexWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a map with a composite index",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "vote", "weight:uint", "--index", "owner,proposalID:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map with a composite index of three fields",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "ballot", "choice", "--index", "chain,round:uint,voter"),
+			step.Workdir(path),
+		)),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
@@ -0,0 +1,42 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestCreateAnteDecoratorsWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create an ante decorator",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "ante", "fee-discount"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a second ante decorator",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "ante", "rate-limit"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing ante decorator",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "ante", "fee-discount"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
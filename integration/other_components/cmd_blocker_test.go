@@ -0,0 +1,85 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestCreateBlockersWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a begin blocker",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "beginblocker", "refresh-prices"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "type", "auction", "item", "deadline:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an end blocker with a queue",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "endblocker", "close-auctions", "--queue", "Auction"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "example", "--require-registration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a type in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "type", "bid", "amount:uint", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a begin blocker in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "beginblocker", "tick", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an end blocker with a queue in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "endblocker", "settle-bids", "--queue", "Bid", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing blocker",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "endblocker", "close-auctions"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating a blocker with a queue of a non existent type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "endblocker", "expire-bids", "--queue", "Unknown"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
@@ -0,0 +1,71 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestCreateEventWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a list emitting events",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "post", "title"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an event",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "event", "post-liked", "postID:uint", "liker:address"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an event with a custom type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "event", "post-shared", "post:Post"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "example", "--require-registration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an event in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "event", "post-liked", "postID:uint", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing event",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "event", "post-liked", "postID:uint"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating an event in a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "event", "post-liked", "postID:uint", "--module", "idontexist"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
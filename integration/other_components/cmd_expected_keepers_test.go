@@ -0,0 +1,72 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+// balanceKeeper calls the keepers of the dependencies of the module
+const balanceKeeper = `package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// SpendableBalance returns the coins an account can spend
+func (k Keeper) SpendableBalance(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	if k.accountKeeper.GetAccount(ctx, addr) == nil {
+		return nil
+	}
+	return k.bankKeeper.SpendableCoins(ctx, addr)
+}
+`
+
+func TestFillExpectedKeepersWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a module with dependencies",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "shop", "--dep", "bank,account"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("fill the expected keepers of a module not calling its dependencies",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "expected-keepers", "--module", "shop"),
+			step.Workdir(path),
+		)),
+	))
+
+	require.NoError(t, os.WriteFile(
+		filepath.Join(path, "x", "shop", "keeper", "balance.go"),
+		[]byte(balanceKeeper),
+		0644,
+	))
+
+	env.Must(env.Exec("fill the expected keepers from the calls of the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "expected-keepers", "--module", "shop"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent filling the expected keepers of a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "expected-keepers", "--module", "idontexist"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
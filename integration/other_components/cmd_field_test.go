@@ -0,0 +1,78 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestAddFieldsWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a list",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "post", "title"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "review", "score:uint", "--index", "kind"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a singleton",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "single", "config", "limit:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("add fields to the list",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "field", "post", "receiver:address", "code:string{regex=^[A-Z]{3}$}", "likes:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("add fields to the map",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "field", "review", "receiver:address", "note"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("add fields to the singleton",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "field", "config", "admin:address", "enabled:bool"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent adding an existing field",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "field", "post", "title"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent adding a field to a non existent type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "field", "comment", "body"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
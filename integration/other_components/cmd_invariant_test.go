@@ -0,0 +1,85 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestCreateInvariantsWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a list with invariants",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "comment", "body", "--invariant"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map with invariants",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "rating", "score:uint", "--index", "item", "--invariant"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list with invariants and no message",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "listing", "title", "--invariant", "--no-message"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an invariant",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "total-supply"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "example", "--require-registration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list with invariants in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "lot", "name", "--invariant", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an invariant in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "pending-bids", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing invariant",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "total-supply"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating an invariant in a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "total-supply", "--module", "idontexist"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
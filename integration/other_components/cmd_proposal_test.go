@@ -0,0 +1,64 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestCreateProposalWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a proposal",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "proposal", "set-limit", "limit:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a proposal with an address and coins",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "proposal", "community-spend", "recipient:address", "amount:coins"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "example", "--require-registration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a proposal in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "proposal", "set-fee", "fee:uint", "--module", "example"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing proposal",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "proposal", "set-limit", "limit:uint"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating a proposal in a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "proposal", "set-fee", "fee:uint", "--module", "idontexist"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
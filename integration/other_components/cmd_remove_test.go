@@ -0,0 +1,161 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestRemoveComponentsWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create an IBC module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "blogibc", "--ibc"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "post", "title"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "review", "score:uint", "--index", "kind"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a singleton",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "single", "config", "limit:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "type", "author", "name"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a message",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "like-post", "postID:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a query",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "query", "posts-by-author", "author"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a packet",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "packet", "share-post", "postID:uint", "--ack", "postID:uint", "--module", "blogibc"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an event",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "event", "post-liked", "postID:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a proposal",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "proposal", "set-limit", "limit:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the list",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "post"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the map",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "review"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the singleton",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "config"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the type",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "author"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the message",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "like-post"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the query",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "posts-by-author"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the packet",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "share-post", "--module", "blogibc"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the event",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "post-liked"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("remove the proposal",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "set-limit"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent removing a removed component",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "remove", "post"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
@@ -0,0 +1,86 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestGenerateAnAppWithUpgradeAndMigrations(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a migration",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "migration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a second migration",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "migration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "foo", "--require-registration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a migration in a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "migration", "--module", "foo"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a migration in a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "migration", "--module", "bar"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("create an upgrade",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v1.1"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a second upgrade",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v2"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing upgrade",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v1.1"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating an upgrade with an invalid name",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "foo"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
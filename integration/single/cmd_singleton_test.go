@@ -74,5 +74,12 @@ func TestCreateSingletonWithStargate(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create an singleton type managed by the admins of a custom module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "single", "settings", "theme", "--module", "example", "--access", "admin"),
+			step.Workdir(path),
+		)),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
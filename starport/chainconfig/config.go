@@ -63,6 +63,7 @@ type Config struct {
 	Init      Init                   `yaml:"init"`
 	Genesis   map[string]interface{} `yaml:"genesis"`
 	Host      Host                   `yaml:"host"`
+	Contracts []Contract             `yaml:"contracts"`
 }
 
 // AccountByName finds account by name.
@@ -179,6 +180,27 @@ type Init struct {
 	KeyringBackend string `yaml:"keyring-backend"`
 }
 
+// Contract holds the options to upload and instantiate a CosmWasm smart contract after genesis.
+type Contract struct {
+	// Path is the path of the compiled contract relative to the app.
+	Path string `yaml:"path"`
+
+	// From is the name of the account uploading and instantiating the contract.
+	From string `yaml:"from"`
+
+	// Label is the label of the contract instance.
+	Label string `yaml:"label"`
+
+	// Init is the message instantiating the contract.
+	Init map[string]interface{} `yaml:"init"`
+
+	// Funds are the coins sent to the contract on instantiation.
+	Funds string `yaml:"funds"`
+
+	// Admin is the address allowed to migrate the contract, the contract can't be migrated if it is empty.
+	Admin string `yaml:"admin"`
+}
+
 // Host keeps configuration related to started servers.
 type Host struct {
 	RPC     string `yaml:"rpc"`
@@ -219,6 +241,17 @@ func validate(conf Config) error {
 	if conf.Validator.Name == "" {
 		return &ValidationError{"validator is required"}
 	}
+	for _, contract := range conf.Contracts {
+		if contract.Path == "" {
+			return &ValidationError{"the path of a contract is required"}
+		}
+		if contract.Label == "" {
+			return &ValidationError{fmt.Sprintf("the label of the contract %s is required", contract.Path)}
+		}
+		if _, found := conf.AccountByName(contract.From); !found {
+			return &ValidationError{fmt.Sprintf("the account of the contract %s must be in accounts", contract.Path)}
+		}
+	}
 	return nil
 }
 
@@ -139,3 +139,57 @@ faucet:
 	require.NoError(t, err)
 	require.Equal(t, ":4700", FaucetHost(conf))
 }
+
+func TestParseContracts(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+contracts:
+  - path: contracts/counter.wasm
+    from: me
+    label: counter
+    init:
+      count: 1
+      owner:
+        name: me
+    funds: "10token"
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+
+	require.NoError(t, err)
+	require.Equal(t, []Contract{
+		{
+			Path:  "contracts/counter.wasm",
+			From:  "me",
+			Label: "counter",
+			Init: map[string]interface{}{
+				"count": uint64(1),
+				"owner": map[string]interface{}{"name": "me"},
+			},
+			Funds: "10token",
+		},
+	}, conf.Contracts)
+}
+
+func TestParseInvalidContract(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+contracts:
+  - path: contracts/counter.wasm
+    from: you
+    label: counter
+`
+
+	_, err := Parse(strings.NewReader(confyml))
+	require.Equal(t, &ValidationError{"the account of the contract contracts/counter.wasm must be in accounts"}, err)
+}
@@ -170,8 +170,11 @@ func initOptionWithHomeFlag(cmd *cobra.Command, initOptions []network.InitOption
 var (
 	modifyPrefix = color.New(color.FgMagenta).SprintFunc()("modify ")
 	createPrefix = color.New(color.FgGreen).SprintFunc()("create ")
+	deletePrefix = color.New(color.FgRed).SprintFunc()("delete ")
 	removePrefix = func(s string) string {
-		return strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix)
+		s = strings.TrimPrefix(s, modifyPrefix)
+		s = strings.TrimPrefix(s, createPrefix)
+		return strings.TrimPrefix(s, deletePrefix)
 	}
 )
 
@@ -194,6 +197,14 @@ func sourceModificationToString(sm xgenny.SourceModification) (string, error) {
 		}
 		files = append(files, createPrefix+relativePath)
 	}
+	for _, removed := range sm.RemovedFiles() {
+		// get the relative app path from the current directory
+		relativePath, err := relativePath(removed)
+		if err != nil {
+			return "", err
+		}
+		files = append(files, deletePrefix+relativePath)
+	}
 
 	// sort filenames without prefix
 	sort.Slice(files, func(i, j int) bool {
@@ -2,6 +2,7 @@ package starportcmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -12,10 +13,14 @@ import (
 
 // flags related to component scaffolding
 const (
-	flagModule      = "module"
-	flagNoMessage   = "no-message"
-	flagResponse    = "response"
-	flagDescription = "desc"
+	flagModule           = "module"
+	flagNoMessage        = "no-message"
+	flagResponse         = "response"
+	flagDescription      = "desc"
+	flagSecondaryIndexes = "secondary-index"
+	flagInvariant        = "invariant"
+	flagAccess           = "access"
+	flagFromProto        = "from-proto"
 )
 
 // NewScaffold returns a command that groups scaffolding related sub commands.
@@ -32,17 +37,31 @@ CRUD stands for "create, read, update, delete".`,
 
 	c.AddCommand(NewScaffoldChain())
 	c.AddCommand(NewScaffoldModule())
+	c.AddCommand(NewScaffoldExpectedKeepers())
 	c.AddCommand(NewScaffoldList())
 	c.AddCommand(NewScaffoldMap())
 	c.AddCommand(NewScaffoldSingle())
 	c.AddCommand(NewScaffoldType())
+	c.AddCommand(NewScaffoldField())
+	c.AddCommand(NewScaffoldParams())
 	c.AddCommand(NewScaffoldMessage())
 	c.AddCommand(NewScaffoldQuery())
+	c.AddCommand(NewScaffoldEvent())
+	c.AddCommand(NewScaffoldProposal())
+	c.AddCommand(NewScaffoldUpgrade())
+	c.AddCommand(NewScaffoldMigration())
+	c.AddCommand(NewScaffoldInvariant())
+	c.AddCommand(NewScaffoldAnte())
+	c.AddCommand(NewScaffoldBeginBlocker())
+	c.AddCommand(NewScaffoldEndBlocker())
 	c.AddCommand(NewScaffoldPacket())
 	c.AddCommand(NewScaffoldBandchain())
+	c.AddCommand(NewScaffoldOracle())
 	c.AddCommand(NewScaffoldVue())
 	c.AddCommand(NewScaffoldFlutter())
-	// c.AddCommand(NewScaffoldWasm())
+	c.AddCommand(NewScaffoldRemove())
+	c.AddCommand(NewScaffoldWasm())
+	c.AddCommand(NewScaffoldApply())
 
 	return c
 }
@@ -53,12 +72,16 @@ func scaffoldType(
 	kind scaffolder.AddTypeKind,
 ) error {
 	var (
-		typeName       = args[0]
-		fields         = args[1:]
-		moduleName     = flagGetModule(cmd)
-		withoutMessage = flagGetNoMessage(cmd)
-		signer         = flagGetSigner(cmd)
-		appPath        = flagGetPath(cmd)
+		typeName         = args[0]
+		fields           = args[1:]
+		moduleName       = flagGetModule(cmd)
+		withoutMessage   = flagGetNoMessage(cmd)
+		signer           = flagGetSigner(cmd)
+		appPath          = flagGetPath(cmd)
+		secondaryIndexes = flagGetSecondaryIndexes(cmd)
+		invariants       = flagGetInvariant(cmd)
+		access           = flagGetAccess(cmd)
+		fromProto        = flagGetFromProto(cmd)
 	)
 
 	var options []scaffolder.AddTypeOption
@@ -74,6 +97,22 @@ func scaffoldType(
 	} else if signer != "" {
 		options = append(options, scaffolder.TypeWithSigner(signer))
 	}
+	if len(secondaryIndexes) > 0 {
+		options = append(options, scaffolder.TypeWithSecondaryIndexes(secondaryIndexes...))
+	}
+	if invariants {
+		options = append(options, scaffolder.TypeWithInvariants())
+	}
+	if access != "" {
+		options = append(options, scaffolder.TypeWithAccess(access))
+	}
+	if fromProto != "" {
+		separator := strings.LastIndex(fromProto, ":")
+		if separator <= 0 || separator == len(fromProto)-1 {
+			return fmt.Errorf("invalid proto message %s, expected path/to/file.proto:Message", fromProto)
+		}
+		options = append(options, scaffolder.TypeFromProto(fromProto[:separator], fromProto[separator+1:]))
+	}
 
 	s := clispinner.New().SetText("Scaffolding...")
 	defer s.Stop()
@@ -109,6 +148,30 @@ func flagSetScaffoldType() *flag.FlagSet {
 	return f
 }
 
+func flagSetSecondaryIndexes() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.StringSlice(flagSecondaryIndexes, []string{}, "Fields of the type to query the values by")
+	return f
+}
+
+func flagSetInvariant() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.Bool(flagInvariant, false, "Register invariants checking the consistency of the store of the type")
+	return f
+}
+
+func flagSetAccess() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.String(flagAccess, "", "Accounts authorized to update and delete the values: owner (with ownership transfer), admin (owner and admins of the module) or open. Default: the owner only, without ownership transfer")
+	return f
+}
+
+func flagSetFromProto() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.String(flagFromProto, "", "Existing proto message of the module to store, as proto/<module>/file.proto:Message relative to the app (the file must be in the proto directory of the module)")
+	return f
+}
+
 func flagGetModule(cmd *cobra.Command) string {
 	module, _ := cmd.Flags().GetString(flagModule)
 	return module
@@ -123,3 +186,23 @@ func flagGetSigner(cmd *cobra.Command) string {
 	signer, _ := cmd.Flags().GetString(flagSigner)
 	return signer
 }
+
+func flagGetSecondaryIndexes(cmd *cobra.Command) []string {
+	secondaryIndexes, _ := cmd.Flags().GetStringSlice(flagSecondaryIndexes)
+	return secondaryIndexes
+}
+
+func flagGetInvariant(cmd *cobra.Command) bool {
+	invariant, _ := cmd.Flags().GetBool(flagInvariant)
+	return invariant
+}
+
+func flagGetAccess(cmd *cobra.Command) string {
+	access, _ := cmd.Flags().GetString(flagAccess)
+	return access
+}
+
+func flagGetFromProto(cmd *cobra.Command) string {
+	fromProto, _ := cmd.Flags().GetString(flagFromProto)
+	return fromProto
+}
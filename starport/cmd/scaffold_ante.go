@@ -0,0 +1,58 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldAnte returns the command to scaffold decorators of the AnteHandler of the app
+func NewScaffoldAnte() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ante [name]",
+		Short: "Decorator of the AnteHandler of the app",
+		Long: `Decorator of the AnteHandler of the app
+
+A decorator [Name]Decorator is scaffolded in app/ante with a unit test. The
+decorators are chained in app/ante/handler.go after the decorators of the
+default SDK AnteHandler, in the order they are scaffolded. The first time a
+decorator is scaffolded, app.go is changed to use the AnteHandler of app/ante.`,
+		Args: cobra.ExactArgs(1),
+		RunE: anteHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func anteHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddAnteDecorator(cmd.Context(), clipper.New(), args[0])
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created an ante decorator `%[1]v`.\n\n", args[0])
+
+	return nil
+}
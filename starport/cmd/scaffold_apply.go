@@ -0,0 +1,103 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/services/scaffolder"
+)
+
+// NewScaffoldApply returns the command to scaffold the modules and components declared in a schema file.
+func NewScaffoldApply() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "apply [schema.yml]",
+		Short: "Scaffold the modules, types, messages, queries and packets declared in a schema file",
+		Long: `Scaffold the modules, types, messages, queries and packets declared in a schema file.
+
+The components are scaffolded in a single pass: the code is generated from the
+proto files and the app is tidied and formatted once at the end. The modules and
+components already scaffolded are skipped and the new fields declared for an
+existing type are added to it, so applying an unchanged schema is a no-op.
+
+The changes of the ibc option or the dependencies of an existing module and of the
+kind, the indexes, the signer or the datatype of the fields of an existing type
+can't be scaffolded and are reported as errors before anything is scaffolded.
+
+modules:
+  - name: blog
+    dependencies: [bank]
+    types:
+      - name: post
+        kind: list # list, map, single or type
+        fields: [title, body, votes:uint]
+      - name: category
+        kind: map
+        indexes: [slug]
+        fields: [description]
+    messages:
+      - name: vote
+        fields: [postID:uint]
+        response: [votes:uint]
+    queries:
+      - name: posts-by-category
+        request: [category]
+        response: [ids:array.uint]
+        paginated: true
+  - name: feed
+    ibc: true
+    packets:
+      - name: share
+        fields: [postID:uint]
+        ack: [received:bool]`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldApplyHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func scaffoldApplyHandler(cmd *cobra.Command, args []string) error {
+	var (
+		schemaPath = args[0]
+		appPath    = flagGetPath(cmd)
+	)
+
+	schema, err := scaffolder.ParseSchemaFile(schemaPath)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.Apply(cmd.Context(), clipper.New(), schema)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(sm.ModifiedFiles())+len(sm.CreatedFiles())+len(sm.RemovedFiles()) == 0 {
+		fmt.Printf("\n✅ The app is up to date with %s.\n\n", schemaPath)
+		return nil
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Applied %s.\n\n", schemaPath)
+
+	return nil
+}
@@ -1,13 +1,8 @@
 package starportcmd
 
 import (
-	"errors"
-	"fmt"
-
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/clipper"
-	"github.com/tendermint/starport/starport/pkg/clispinner"
-	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/tendermint/starport/starport/templates/ibc"
 )
 
 // NewScaffoldBandchain creates a new BandChain oracle in the module
@@ -28,57 +23,5 @@ func NewScaffoldBandchain() *cobra.Command {
 }
 
 func createBandchainHandler(cmd *cobra.Command, args []string) error {
-	var (
-		oracle  = args[0]
-		appPath = flagGetPath(cmd)
-		signer  = flagGetSigner(cmd)
-	)
-
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
-
-	module, err := cmd.Flags().GetString(flagModule)
-	if err != nil {
-		return err
-	}
-	if module == "" {
-		return errors.New("please specify a module to create the BandChain oracle into: --module <module_name>")
-	}
-
-	var options []scaffolder.OracleOption
-	if signer != "" {
-		options = append(options, scaffolder.OracleWithSigner(signer))
-	}
-
-	sc, err := newApp(appPath)
-	if err != nil {
-		return err
-	}
-
-	sm, err := sc.AddOracle(clipper.New(), module, oracle, options...)
-	if err != nil {
-		return err
-	}
-
-	s.Stop()
-
-	modificationsStr, err := sourceModificationToString(sm)
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(modificationsStr)
-
-	fmt.Printf(`
-🎉 Created a Band oracle query "%[1]v".
-
-Note: BandChain module uses version "bandchain-1".
-Make sure to update the keys.go file accordingly.
-
-// x/%[2]v/types/keys.go
-const Version = "bandchain-1"
-
-`, oracle, module)
-
-	return nil
+	return scaffoldOracle(cmd, args[0], ibc.OracleProviderBand)
 }
@@ -0,0 +1,93 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/tendermint/starport/starport/templates/blocker"
+)
+
+const flagQueue = "queue"
+
+// NewScaffoldBeginBlocker returns the command to scaffold a logic executed at the beginning of every block
+func NewScaffoldBeginBlocker() *cobra.Command {
+	return newScaffoldBlocker(
+		"beginblocker",
+		blocker.HookBeginBlock,
+		"Logic executed by a module at the beginning of every block",
+	)
+}
+
+// NewScaffoldEndBlocker returns the command to scaffold a logic executed at the end of every block
+func NewScaffoldEndBlocker() *cobra.Command {
+	return newScaffoldBlocker(
+		"endblocker",
+		blocker.HookEndBlock,
+		"Logic executed by a module at the end of every block",
+	)
+}
+
+func newScaffoldBlocker(use string, hook blocker.Hook, short string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " [name]",
+		Short: short,
+		Long: fmt.Sprintf(`%[1]v
+
+A keeper method %[2]v[Name] is scaffolded and called from the %[2]v method of the module.
+
+With --queue, the blocker processes a time-ordered queue of items of a type of the module:
+the items are added to the queue with an execution time and processed once it has passed.
+The items of the queue and their count are exported and imported with the genesis of the module.`, short, hook),
+		Args: cobra.ExactArgs(1),
+		RunE: blockerHandler(hook),
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the blocker into. Default: app's main module")
+	c.Flags().String(flagQueue, "", "Type of the items of a time-ordered queue processed by the blocker")
+
+	return c
+}
+
+func blockerHandler(hook blocker.Hook) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var (
+			module, _    = cmd.Flags().GetString(flagModule)
+			queueType, _ = cmd.Flags().GetString(flagQueue)
+			appPath      = flagGetPath(cmd)
+		)
+
+		s := clispinner.New().SetText("Scaffolding...")
+		defer s.Stop()
+
+		var options []scaffolder.BlockerOption
+		if queueType != "" {
+			options = append(options, scaffolder.BlockerWithQueue(queueType))
+		}
+
+		sc, err := newApp(appPath)
+		if err != nil {
+			return err
+		}
+
+		sm, err := sc.AddBlocker(cmd.Context(), clipper.New(), hook, module, args[0], options...)
+		if err != nil {
+			return err
+		}
+
+		s.Stop()
+
+		modificationsStr, err := sourceModificationToString(sm)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(modificationsStr)
+		fmt.Printf("\n🎉 Created a blocker `%[1]v` called by %[2]v.\n\n", args[0], hook)
+
+		return nil
+	}
+}
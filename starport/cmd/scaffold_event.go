@@ -0,0 +1,60 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldEvent returns the command to scaffold typed events
+func NewScaffoldEvent() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "event [name] [field1] [field2] ...",
+		Short: "Typed event emitted by a module",
+		Long: `Typed event emitted by a module
+
+The event is defined in the events.proto file of the module and a keeper method
+Emit[Name]Event is scaffolded to emit it from the module's logic.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: eventHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the event into. Default: app's main module")
+
+	return c
+}
+
+func eventHandler(cmd *cobra.Command, args []string) error {
+	var (
+		module, _ = cmd.Flags().GetString(flagModule)
+		appPath   = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddEvent(cmd.Context(), clipper.New(), module, args[0], args[1:])
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created an event `%[1]v`.\n\n", args[0])
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldExpectedKeepers returns the command to fill the expected keepers of a module from their usage
+func NewScaffoldExpectedKeepers() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "expected-keepers",
+		Short: "Expected keepers of a module declaring the methods it calls",
+		Long: `Expected keepers of a module declaring the methods it calls
+
+The code of the module is analysed to find the methods it calls on the keepers
+it depends on, e.g. the keepers added with "scaffold module --dep". The
+interfaces of types/expected_keepers.go declare exactly these methods with the
+signatures of the keepers given to the module in app.go. Mocks of the expected
+keepers are generated in testutil/keeper for the tests of the keeper.`,
+		Args: cobra.NoArgs,
+		RunE: expectedKeepersHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to fill the expected keepers of. Default: app's main module")
+
+	return c
+}
+
+func expectedKeepersHandler(cmd *cobra.Command, args []string) error {
+	var (
+		module, _ = cmd.Flags().GetString(flagModule)
+		appPath   = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Analysing the module...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.FillExpectedKeepers(cmd.Context(), clipper.New(), module)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Print("\n🎉 Filled the expected keepers.\n\n")
+
+	return nil
+}
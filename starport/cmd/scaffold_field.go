@@ -0,0 +1,64 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldField returns a new command to add fields to a scaffolded type.
+func NewScaffoldField() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "field TYPE [field]...",
+		Short: "Add fields to a type previously scaffolded",
+		Long: `Add fields to a list, map, single or type previously scaffolded in a module.
+
+The fields are added to the type and to the messages, CLI commands and tests
+scaffolded with it. The sample values of the singletons in the genesis tests
+get the new fields. The genesis of the lists and maps and the simulation are
+left unchanged: they only set the id or the indexes of the values.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: scaffoldFieldHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module the type is scaffolded in. Default: app's main module")
+
+	return c
+}
+
+func scaffoldFieldHandler(cmd *cobra.Command, args []string) error {
+	var (
+		typeName   = args[0]
+		fields     = args[1:]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddFields(cmd.Context(), clipper.New(), moduleName, typeName, fields)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Fields added to %s.\n\n", typeName)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldInvariant returns the command to scaffold invariants of modules
+func NewScaffoldInvariant() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "invariant [name]",
+		Short: "Invariant of the state of a module",
+		Long: `Invariant of the state of a module
+
+A keeper function [Name]Invariant is scaffolded and registered by the module,
+the invariant is checked by the crisis module of the app.`,
+		Args: cobra.ExactArgs(1),
+		RunE: invariantHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the invariant into. Default: app's main module")
+
+	return c
+}
+
+func invariantHandler(cmd *cobra.Command, args []string) error {
+	var (
+		module, _ = cmd.Flags().GetString(flagModule)
+		appPath   = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddInvariant(cmd.Context(), clipper.New(), module, args[0])
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created an invariant `%[1]v`.\n\n", args[0])
+
+	return nil
+}
@@ -16,6 +16,10 @@ func NewScaffoldList() *cobra.Command {
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetSecondaryIndexes())
+	c.Flags().AddFlagSet(flagSetInvariant())
+	c.Flags().AddFlagSet(flagSetAccess())
+	c.Flags().AddFlagSet(flagSetFromProto())
 
 	return c
 }
@@ -1,6 +1,8 @@
 package starportcmd
 
 import (
+	"strings"
+
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/services/scaffolder"
 )
@@ -20,6 +22,10 @@ func NewScaffoldMap() *cobra.Command {
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetSecondaryIndexes())
+	c.Flags().AddFlagSet(flagSetInvariant())
+	c.Flags().AddFlagSet(flagSetAccess())
+	c.Flags().AddFlagSet(flagSetFromProto())
 	c.Flags().StringSlice(FlagIndexes, []string{"index"}, "fields that index the value")
 
 	return c
@@ -31,5 +37,20 @@ func scaffoldMapHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return scaffoldType(cmd, args, scaffolder.MapType(indexes...))
+	return scaffoldType(cmd, args, scaffolder.MapType(joinEnumDeclarations(indexes)...))
+}
+
+// joinEnumDeclarations joins back the values of the enums declared in comma separated flag values
+// e.g. "status:enum(active", "closed)" is joined as "status:enum(active,closed)"
+func joinEnumDeclarations(values []string) []string {
+	var joined []string
+	for _, value := range values {
+		last := len(joined) - 1
+		if last >= 0 && strings.Count(joined[last], "(") > strings.Count(joined[last], ")") {
+			joined[last] += "," + value
+			continue
+		}
+		joined = append(joined, value)
+	}
+	return joined
 }
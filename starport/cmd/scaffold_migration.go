@@ -0,0 +1,62 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldMigration returns the command to scaffold store migrations of modules
+func NewScaffoldMigration() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migration",
+		Short: "In-place store migration of a module",
+		Long: `In-place store migration of a module
+
+The consensus version of the module is bumped and a package migrations/v[N] is
+scaffolded with the migration of the store from the previous version. The
+migration is registered in the configurator of the module, it is run by the
+software upgrade handlers of the app.`,
+		Args: cobra.NoArgs,
+		RunE: migrationHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the migration into. Default: app's main module")
+
+	return c
+}
+
+func migrationHandler(cmd *cobra.Command, args []string) error {
+	var (
+		module, _ = cmd.Flags().GetString(flagModule)
+		appPath   = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddMigration(cmd.Context(), clipper.New(), module)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Print("\n🎉 Created a store migration.\n\n")
+
+	return nil
+}
@@ -20,6 +20,7 @@ const (
 	flagIBC                 = "ibc"
 	flagParams              = "params"
 	flagIBCOrdering         = "ordering"
+	flagIBCMiddleware       = "ibc-middleware"
 	flagRequireRegistration = "require-registration"
 )
 
@@ -37,9 +38,12 @@ func NewScaffoldModule() *cobra.Command {
 	c.Flags().StringSlice(flagDep, []string{}, "module dependencies (e.g. --dep account,bank)")
 	c.Flags().Bool(flagIBC, false, "scaffold an IBC module")
 	c.Flags().String(flagIBCOrdering, "none", "channel ordering of the IBC module [none|ordered|unordered]")
+	c.Flags().String(flagIBCMiddleware, "", "scaffold a middleware wrapping the IBC module of an app [transfer]")
 	c.Flags().Bool(flagRequireRegistration, false, "if true command will fail if module can't be registered")
 	c.Flags().StringSlice(flagParams, []string{}, "scaffold module params")
 
+	c.AddCommand(NewScaffoldModuleImport())
+
 	return c
 }
 
@@ -60,6 +64,11 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	ibcMiddleware, err := cmd.Flags().GetString(flagIBCMiddleware)
+	if err != nil {
+		return err
+	}
+
 	requireRegistration, err := cmd.Flags().GetBool(flagRequireRegistration)
 	if err != nil {
 		return err
@@ -79,6 +88,11 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithIBCChannelOrdering(ibcOrdering), scaffolder.WithIBC())
 	}
 
+	// Check if the module must wrap the IBC module of an app
+	if ibcMiddleware != "" {
+		options = append(options, scaffolder.WithIBCMiddleware(ibcMiddleware))
+	}
+
 	// Get module dependencies
 	dependencies, err := cmd.Flags().GetStringSlice(flagDep)
 	if err != nil {
@@ -0,0 +1,70 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldModuleImport returns the command to import a Cosmos SDK module from a Go module
+func NewScaffoldModuleImport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "import [go-package-path][@version]",
+		Short: "Import a Cosmos SDK module from a Go module",
+		Long: `Import a Cosmos SDK module from a Go module
+
+The Go module providing the package of the Cosmos SDK module is added to the requirements of the app and the module
+is wired into app/app.go. When the module can't be wired automatically, the app is left as is and a checklist of
+the changes to make in go.mod and app/app.go is printed instead.`,
+		Example: "starport scaffold module import github.com/cosmos/ibc-go/v2/modules/apps/27-interchain-accounts@v2.0.0",
+		Args:    cobra.ExactArgs(1),
+		RunE:    scaffoldModuleImportHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func scaffoldModuleImportHandler(cmd *cobra.Command, args []string) error {
+	var (
+		pkg     = args[0]
+		appPath = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, checklist, err := sc.ImportGoModule(cmd.Context(), clipper.New(), pkg)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(checklist) > 0 {
+		fmt.Printf("\n⚠️  %s can't be wired automatically, the app is left as is.\n", pkg)
+		fmt.Print("Import the module with these steps:\n\n")
+		for i, step := range checklist {
+			fmt.Printf("%d. %s\n", i+1, step)
+		}
+		return nil
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Imported %s.\n\n", pkg)
+
+	return nil
+}
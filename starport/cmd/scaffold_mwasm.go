@@ -8,13 +8,20 @@ import (
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 )
 
+// NewScaffoldWasm returns the command to import the wasm module
 func NewScaffoldWasm() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "wasm",
 		Short: "Import the wasm module to your app",
-		Long:  "Add support for WebAssembly smart contracts to your blockchain",
-		Args:  cobra.NoArgs,
-		RunE:  scaffoldWasmHandler,
+		Long: `Add support for WebAssembly smart contracts to your blockchain
+
+The wasm keeper, its proposals and its ante decorators are wired into the app. The contracts listed in the
+contracts section of config.yml are uploaded and instantiated by "starport chain serve" after genesis.
+
+The version of wasmd imported requires the Cosmos SDK v0.45 or later and ibc-go v2, apps using older
+versions must be upgraded first.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldWasmHandler,
 	}
 
 	flagSetPath(c)
@@ -33,7 +40,7 @@ func scaffoldWasmHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.ImportModule(clipper.New(), "wasm")
+	sm, err := sc.ImportModule(cmd.Context(), clipper.New(), "wasm")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,104 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/tendermint/starport/starport/templates/ibc"
+)
+
+const flagProvider = "provider"
+
+// NewScaffoldOracle creates a new oracle query answered by an oracle provider in the module
+func NewScaffoldOracle() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "oracle [queryName] --module [moduleName] --provider [provider]",
+		Short: "Scaffold an IBC oracle query to request data from an oracle chain or module",
+		Long: fmt.Sprintf(
+			"Scaffold an IBC oracle query to request data from an oracle chain or module in a specific IBC-enabled Cosmos SDK module. The providers are: %s",
+			strings.Join(ibc.OracleProviderNames(), ", "),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: createOracleHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
+	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
+	c.Flags().String(flagProvider, ibc.OracleProviderBand, "Provider answering the oracle query")
+
+	return c
+}
+
+func createOracleHandler(cmd *cobra.Command, args []string) error {
+	provider, err := cmd.Flags().GetString(flagProvider)
+	if err != nil {
+		return err
+	}
+	return scaffoldOracle(cmd, args[0], provider)
+}
+
+func scaffoldOracle(cmd *cobra.Command, oracle, provider string) error {
+	var (
+		appPath = flagGetPath(cmd)
+		signer  = flagGetSigner(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	module, err := cmd.Flags().GetString(flagModule)
+	if err != nil {
+		return err
+	}
+	if module == "" {
+		return errors.New("please specify a module to create the oracle into: --module <module_name>")
+	}
+
+	options := []scaffolder.OracleOption{scaffolder.OracleWithProvider(provider)}
+	if signer != "" {
+		options = append(options, scaffolder.OracleWithSigner(signer))
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddOracle(clipper.New(), module, oracle, options...)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+
+	if provider != ibc.OracleProviderBand {
+		fmt.Printf("\n🎉 Created a %s oracle query \"%s\".\n\n", provider, oracle)
+		return nil
+	}
+
+	fmt.Printf(`
+🎉 Created a Band oracle query "%[1]v".
+
+Note: BandChain module uses version "bandchain-1".
+Make sure to update the keys.go file accordingly.
+
+// x/%[2]v/types/keys.go
+const Version = "bandchain-1"
+
+`, oracle, module)
+
+	return nil
+}
@@ -11,7 +11,10 @@ import (
 )
 
 const (
-	flagAck = "ack"
+	flagAck             = "ack"
+	flagTimeoutHeight   = "timeout-height"
+	flagTimeoutDuration = "timeout-duration"
+	flagRetry           = "retry"
 )
 
 // NewScaffoldPacket creates a new packet in the module
@@ -29,6 +32,9 @@ func NewScaffoldPacket() *cobra.Command {
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
 	c.Flags().Bool(flagNoMessage, false, "Disable send message scaffolding")
+	c.Flags().Uint64(flagTimeoutHeight, 0, "Default packet timeout block height relative to the counterparty chain (0 disables the timeout height)")
+	c.Flags().Duration(flagTimeoutDuration, scaffolder.DefaultPacketTimeoutDuration, "Default packet timeout relative to the time of the counterparty chain (0 disables the timeout duration)")
+	c.Flags().Uint64(flagRetry, 0, "Maximum number of times a timed out packet is re-sent from EndBlock (0 disables the retries)")
 
 	return c
 }
@@ -62,7 +68,26 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	var options []scaffolder.PacketOption
+	timeoutHeight, err := cmd.Flags().GetUint64(flagTimeoutHeight)
+	if err != nil {
+		return err
+	}
+
+	timeoutDuration, err := cmd.Flags().GetDuration(flagTimeoutDuration)
+	if err != nil {
+		return err
+	}
+
+	maxRetries, err := cmd.Flags().GetUint64(flagRetry)
+	if err != nil {
+		return err
+	}
+
+	options := []scaffolder.PacketOption{
+		scaffolder.PacketWithTimeoutHeight(timeoutHeight),
+		scaffolder.PacketWithTimeoutDuration(timeoutDuration),
+		scaffolder.PacketWithRetry(maxRetries),
+	}
 	if noMessage {
 		options = append(options, scaffolder.PacketWithoutMessage())
 	} else if signer != "" {
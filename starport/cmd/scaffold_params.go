@@ -0,0 +1,94 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+// NewScaffoldParams returns a new command to add params to a module.
+func NewScaffoldParams() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "params [param]...",
+		Short: "Add params to a module",
+		Long: `Add params to the params subspace of a module.
+
+The params are added to the genesis and to the simulation of the module with
+a validation function and its tests for each param. The params subspace is
+created if the module doesn't define params yet.
+
+The chains already running have no value for the new params: set them from a
+store migration of the module run by a software upgrade before they are read.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: scaffoldParamsHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the params into. Default: app's main module")
+
+	return c
+}
+
+func scaffoldParamsHandler(cmd *cobra.Command, args []string) error {
+	var (
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddParams(cmd.Context(), clipper.New(), moduleName, args)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Params added: %s.\n\n", strings.Join(args, ", "))
+
+	return paramsWarning(moduleName, args)
+}
+
+// paramsWarning prints a warning to set the new params on the chains already running, the params subspace of a
+// module panics when a param without value is read
+func paramsWarning(moduleName string, params []string) error {
+	var sets strings.Builder
+	for _, param := range params {
+		name, err := multiformatname.NewName(strings.SplitN(param, datatype.Separator, 2)[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&sets, "\tm.keeper.paramstore.Set(ctx, types.Key%[1]v, types.Default%[1]v)\n", name.UpperCamel)
+	}
+
+	moduleFlag := ""
+	if moduleName != "" {
+		moduleFlag = " --module " + moduleName
+	}
+
+	fmt.Printf(`⚠️ The chains already running have no value for the new params, reading the params panics until they are set.
+Set them from a store migration of the module scaffolded with "starport scaffold migration%s":
+
+%s
+Then run the migration from an upgrade handler scaffolded with "starport scaffold upgrade [name]".
+
+`, moduleFlag, sets.String())
+	return nil
+}
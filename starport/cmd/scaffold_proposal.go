@@ -0,0 +1,62 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldProposal returns the command to scaffold governance proposals
+func NewScaffoldProposal() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "proposal [name] [field1] [field2] ...",
+		Short: "Governance proposal handled by a module",
+		Long: `Governance proposal handled by a module
+
+The proposal content is defined in the proposal.proto file of the module and
+routed to the module by the gov router of the app. A keeper method
+Handle[Name]Proposal is scaffolded to execute the proposal once accepted, and
+a command is added to "tx gov submit-proposal" to submit it.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: proposalHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the proposal into. Default: app's main module")
+
+	return c
+}
+
+func proposalHandler(cmd *cobra.Command, args []string) error {
+	var (
+		module, _ = cmd.Flags().GetString(flagModule)
+		appPath   = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddProposal(cmd.Context(), clipper.New(), module, args[0], args[1:])
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created a proposal `%[1]v`.\n\n", args[0])
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldRemove returns a new command to remove a scaffolded component.
+func NewScaffoldRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "remove [name]",
+		Short: "Remove a type, message, query, packet, event or proposal previously scaffolded",
+		Long: `Remove a type, message, query, packet, event or proposal previously scaffolded in a module.
+
+The files created for the component are deleted and the code added to the existing
+files of the module is removed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldRemoveHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to remove the component from. Default: app's main module")
+
+	return c
+}
+
+func scaffoldRemoveHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name       = args[0]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Removing...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.RemoveComponent(clipper.New(), moduleName, name)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🗑  %s removed. \n\n", name)
+
+	return nil
+}
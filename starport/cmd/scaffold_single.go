@@ -16,6 +16,7 @@ func NewScaffoldSingle() *cobra.Command {
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetAccess())
 
 	return c
 }
@@ -0,0 +1,58 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewScaffoldUpgrade returns the command to scaffold software upgrade handlers
+func NewScaffoldUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade [name]",
+		Short: "Software upgrade handler of the app",
+		Long: `Software upgrade handler of the app
+
+The name of the upgrade is a version like v1.1, it must be the name of the
+software upgrade plan. A package app/upgrades/[name] is scaffolded with the
+handler executed when the chain reaches the height of the plan, the handler
+runs the store migrations registered by the modules.`,
+		Args: cobra.ExactArgs(1),
+		RunE: upgradeHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func upgradeHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddUpgrade(cmd.Context(), clipper.New(), args[0])
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created an upgrade handler `%[1]v`.\n\n", args[0])
+
+	return nil
+}
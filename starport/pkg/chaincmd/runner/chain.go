@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -112,7 +113,8 @@ func (r Runner) ShowNodeID(ctx context.Context) (nodeID string, err error) {
 
 // NodeStatus keeps info about node's status.
 type NodeStatus struct {
-	ChainID string
+	ChainID           string
+	LatestBlockHeight int64
 }
 
 // Status returns the node's status.
@@ -123,7 +125,7 @@ func (r Runner) Status(ctx context.Context) (NodeStatus, error) {
 		return NodeStatus{}, err
 	}
 
-	var chainID string
+	var chainID, latestBlockHeight string
 
 	data, err := b.JSONEnsuredBytes()
 	if err != nil {
@@ -137,6 +139,9 @@ func (r Runner) Status(ctx context.Context) (NodeStatus, error) {
 			NodeInfo struct {
 				Network string `json:"network"`
 			} `json:"NodeInfo"`
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"SyncInfo"`
 		}{}
 
 		if err := json.Unmarshal(data, &out); err != nil {
@@ -144,11 +149,15 @@ func (r Runner) Status(ctx context.Context) (NodeStatus, error) {
 		}
 
 		chainID = out.NodeInfo.Network
+		latestBlockHeight = out.SyncInfo.LatestBlockHeight
 	default:
 		out := struct {
 			NodeInfo struct {
 				Network string `json:"network"`
 			} `json:"node_info"`
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
 		}{}
 
 		if err := json.Unmarshal(data, &out); err != nil {
@@ -156,11 +165,19 @@ func (r Runner) Status(ctx context.Context) (NodeStatus, error) {
 		}
 
 		chainID = out.NodeInfo.Network
+		latestBlockHeight = out.SyncInfo.LatestBlockHeight
 	}
 
-	return NodeStatus{
+	status := NodeStatus{
 		ChainID: chainID,
-	}, nil
+	}
+	if latestBlockHeight != "" {
+		if status.LatestBlockHeight, err = strconv.ParseInt(latestBlockHeight, 10, 64); err != nil {
+			return NodeStatus{}, err
+		}
+	}
+
+	return status, nil
 }
 
 // BankSend sends amount from fromAccount to toAccount.
@@ -0,0 +1,117 @@
+package chaincmdrunner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+const (
+	wasmEventStoreCode           = "store_code"
+	wasmEventInstantiate         = "instantiate"
+	wasmAttributeCodeID          = "code_id"
+	wasmAttributeContractAddress = "_contract_address"
+)
+
+// WasmStore uploads the compiled contract at path from fromAccount and returns the id of the stored code.
+func (r Runner) WasmStore(ctx context.Context, fromAccount, path string) (codeID uint64, err error) {
+	value, err := r.wasmTx(ctx, r.chainCmd.WasmStoreCommand(fromAccount, path), wasmEventStoreCode, wasmAttributeCodeID)
+	if err != nil {
+		return 0, fmt.Errorf("cannot store the contract %s: %w", path, err)
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// WasmInstantiate instantiates the stored code with codeID from fromAccount and returns the address of the contract.
+// The contract can't be migrated if admin is empty.
+func (r Runner) WasmInstantiate(
+	ctx context.Context,
+	fromAccount string,
+	codeID uint64,
+	msg,
+	label,
+	funds,
+	admin string,
+) (address string, err error) {
+	address, err = r.wasmTx(
+		ctx,
+		r.chainCmd.WasmInstantiateCommand(fromAccount, codeID, msg, label, funds, admin),
+		wasmEventInstantiate,
+		wasmAttributeContractAddress,
+	)
+	if err != nil {
+		return "", fmt.Errorf("cannot instantiate the contract %s: %w", label, err)
+	}
+	return address, nil
+}
+
+// wasmTx broadcasts a wasm transaction and returns the value of the attribute with key of the event with eventType
+// emitted by the transaction.
+func (r Runner) wasmTx(ctx context.Context, command step.Option, eventType, key string) (string, error) {
+	b := newBuffer()
+	opt := []step.Option{
+		command,
+	}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+
+	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
+		return "", err
+	}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return "", err
+	}
+	return wasmTxAttribute(data, eventType, key)
+}
+
+// wasmTxAttribute returns the value of the attribute with key of the event with eventType from the JSON output of a
+// transaction, the transaction is expected to be successful.
+func wasmTxAttribute(data []byte, eventType, key string) (string, error) {
+	out := struct {
+		Code  int    `json:"code"`
+		Error string `json:"raw_log"`
+		Logs  []struct {
+			Events []struct {
+				Type  string `json:"type"`
+				Attrs []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"attributes"`
+			} `json:"events"`
+		} `json:"logs"`
+	}{}
+
+	if err := json.Unmarshal(data, &out); err != nil {
+		return "", err
+	}
+
+	if out.Code > 0 {
+		return "", fmt.Errorf("SDK code %d: %s", out.Code, out.Error)
+	}
+
+	for _, log := range out.Logs {
+		for _, event := range log.Events {
+			if event.Type != eventType {
+				continue
+			}
+			for _, attr := range event.Attrs {
+				if attr.Key == key {
+					return attr.Value, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("the %s event of the transaction has no %s", eventType, key)
+}
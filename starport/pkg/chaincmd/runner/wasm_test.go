@@ -0,0 +1,130 @@
+package chaincmdrunner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/chaincmd"
+)
+
+const storeCodeOutput = `{
+  "code": 0,
+  "raw_log": "",
+  "logs": [{
+    "events": [
+      {"type": "message", "attributes": [{"key": "action", "value": "store-code"}]},
+      {"type": "store_code", "attributes": [{"key": "code_id", "value": "3"}]}
+    ]
+  }]
+}`
+
+func TestWasmTxAttribute(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		output    string
+		eventType string
+		key       string
+		value     string
+		err       string
+	}{
+		{
+			desc:      "attribute",
+			output:    storeCodeOutput,
+			eventType: "store_code",
+			key:       "code_id",
+			value:     "3",
+		},
+		{
+			desc:      "no event",
+			output:    storeCodeOutput,
+			eventType: "instantiate",
+			key:       "_contract_address",
+			err:       "the instantiate event of the transaction has no _contract_address",
+		},
+		{
+			desc:      "no attribute",
+			output:    storeCodeOutput,
+			eventType: "store_code",
+			key:       "creator",
+			err:       "the store_code event of the transaction has no creator",
+		},
+		{
+			desc:      "failed transaction",
+			output:    `{"code": 5, "raw_log": "insufficient funds"}`,
+			eventType: "store_code",
+			key:       "code_id",
+			err:       "SDK code 5: insufficient funds",
+		},
+		{
+			desc:   "invalid output",
+			output: "{",
+			err:    "unexpected end of JSON input",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			value, err := wasmTxAttribute([]byte(tc.output), tc.eventType, tc.key)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.value, value)
+		})
+	}
+}
+
+// newFakeApp returns the path of a binary printing output and writing its arguments to the returned file.
+func newFakeApp(t *testing.T, output string) (binary, argsFile string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	binary = filepath.Join(dir, "marsd")
+	argsFile = filepath.Join(dir, "args")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %s\ncat <<'EOF'\n%s\nEOF\n", argsFile, output)
+	require.NoError(t, os.WriteFile(binary, []byte(script), 0755))
+	return binary, argsFile
+}
+
+func TestWasmStore(t *testing.T) {
+	binary, argsFile := newFakeApp(t, storeCodeOutput)
+	r, err := New(context.Background(), chaincmd.New(binary))
+	require.NoError(t, err)
+
+	codeID, err := r.WasmStore(context.Background(), "alice", "counter.wasm")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), codeID)
+
+	args, err := os.ReadFile(argsFile)
+	require.NoError(t, err)
+	require.Contains(t, string(args), "tx wasm store counter.wasm --from alice")
+}
+
+func TestWasmInstantiate(t *testing.T) {
+	binary, _ := newFakeApp(t, `{
+  "code": 0,
+  "logs": [{
+    "events": [
+      {"type": "instantiate", "attributes": [{"key": "_contract_address", "value": "cosmos1contract"}, {"key": "code_id", "value": "3"}]}
+    ]
+  }]
+}`)
+	r, err := New(context.Background(), chaincmd.New(binary))
+	require.NoError(t, err)
+
+	address, err := r.WasmInstantiate(context.Background(), "alice", 3, "{}", "counter", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1contract", address)
+}
+
+func TestWasmInstantiateError(t *testing.T) {
+	binary, _ := newFakeApp(t, `{"code": 5, "raw_log": "insufficient funds"}`)
+	r, err := New(context.Background(), chaincmd.New(binary))
+	require.NoError(t, err)
+
+	_, err = r.WasmInstantiate(context.Background(), "alice", 3, "{}", "counter", "", "")
+	require.EqualError(t, err, "cannot instantiate the contract counter: SDK code 5: insufficient funds")
+}
@@ -0,0 +1,94 @@
+package chaincmd
+
+import (
+	"strconv"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+const (
+	commandWasm = "wasm"
+
+	optionFrom          = "--from"
+	optionGas           = "--gas"
+	optionGasAdjustment = "--gas-adjustment"
+	optionBroadcastMode = "--broadcast-mode"
+	optionLabel         = "--label"
+	optionAdmin         = "--admin"
+	optionNoAdmin       = "--no-admin"
+
+	constAuto  = "auto"
+	constBlock = "block"
+
+	// wasmGasAdjustment is the margin of the estimated gas of the wasm transactions
+	wasmGasAdjustment = "1.3"
+)
+
+// WasmStoreCommand returns the command to upload the compiled contract at path.
+func (c ChainCmd) WasmStoreCommand(fromAccount, path string) step.Option {
+	command := []string{
+		commandTx,
+		commandWasm,
+		"store",
+		path,
+	}
+
+	return c.wasmTxCommand(command, fromAccount)
+}
+
+// WasmInstantiateCommand returns the command to instantiate the stored code with codeID.
+// The contract can't be migrated if admin is empty.
+func (c ChainCmd) WasmInstantiateCommand(
+	fromAccount string,
+	codeID uint64,
+	msg,
+	label,
+	funds,
+	admin string,
+) step.Option {
+	command := []string{
+		commandTx,
+		commandWasm,
+		"instantiate",
+		strconv.FormatUint(codeID, 10),
+		msg,
+		optionLabel,
+		label,
+	}
+
+	if funds != "" {
+		command = append(command, optionAmount, funds)
+	}
+
+	if admin != "" {
+		command = append(command, optionAdmin, admin)
+	} else {
+		command = append(command, optionNoAdmin)
+	}
+
+	return c.wasmTxCommand(command, fromAccount)
+}
+
+// wasmTxCommand appends the flags to sign and broadcast a wasm transaction to the provided command
+// the gas of the transaction is estimated and the command waits for the transaction to be included in a block
+func (c ChainCmd) wasmTxCommand(command []string, fromAccount string) step.Option {
+	command = append(command,
+		optionFrom,
+		fromAccount,
+		optionGas,
+		constAuto,
+		optionGasAdjustment,
+		wasmGasAdjustment,
+		optionBroadcastMode,
+		constBlock,
+		optionOutput,
+		constJSON,
+		optionYes,
+	)
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
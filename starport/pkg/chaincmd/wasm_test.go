@@ -0,0 +1,77 @@
+package chaincmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/chaincmd"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestWasmStoreCommand(t *testing.T) {
+	c := chaincmd.New(
+		"marsd",
+		chaincmd.WithHome("/home/mars"),
+		chaincmd.WithChainID("mars"),
+		chaincmd.WithKeyringBackend(chaincmd.KeyringBackendTest),
+		chaincmd.WithNodeAddress("http://localhost:26657"),
+	)
+
+	s := step.New(c.WasmStoreCommand("alice", "contracts/counter.wasm"))
+	require.Equal(t, "marsd", s.Exec.Command)
+	require.Equal(t, []string{
+		"tx", "wasm", "store", "contracts/counter.wasm",
+		"--from", "alice",
+		"--gas", "auto",
+		"--gas-adjustment", "1.3",
+		"--broadcast-mode", "block",
+		"--output", "json",
+		"--yes",
+		"--chain-id", "mars",
+		"--keyring-backend", "test",
+		"--node", "http://localhost:26657",
+		"--home", "/home/mars",
+	}, s.Exec.Args)
+}
+
+func TestWasmInstantiateCommand(t *testing.T) {
+	c := chaincmd.New("marsd")
+
+	for _, tc := range []struct {
+		desc  string
+		funds string
+		admin string
+		args  []string
+	}{
+		{
+			desc: "no admin",
+			args: []string{"--no-admin"},
+		},
+		{
+			desc:  "admin",
+			admin: "cosmos1admin",
+			args:  []string{"--admin", "cosmos1admin"},
+		},
+		{
+			desc:  "funds",
+			funds: "100token",
+			args:  []string{"--amount", "100token", "--no-admin"},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := step.New(c.WasmInstantiateCommand("alice", 3, `{"count":0}`, "counter", tc.funds, tc.admin))
+
+			args := append([]string{"tx", "wasm", "instantiate", "3", `{"count":0}`, "--label", "counter"}, tc.args...)
+			args = append(args,
+				"--from", "alice",
+				"--gas", "auto",
+				"--gas-adjustment", "1.3",
+				"--broadcast-mode", "block",
+				"--output", "json",
+				"--yes",
+			)
+			require.Equal(t, "marsd", s.Exec.Command)
+			require.Equal(t, args, s.Exec.Args)
+		})
+	}
+}
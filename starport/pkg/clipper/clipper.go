@@ -2,6 +2,8 @@ package clipper
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/tendermint/starport/starport/pkg/placeholder"
 )
@@ -97,6 +99,52 @@ func (c *Clipper) Err() error {
 					missingSelections,
 					fmt.Sprintf("◦ cannot find struct %v in %v", options["structName"], file),
 				)
+			case GoSelectFunctionNewParameterPosition.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v in %v", options["functionName"], file),
+				)
+			case GoSelectFunctionCallNewArgumentPosition.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v which is calling %v in %v",
+						options["functionName"], options["callName"], file),
+				)
+			case GoSelectAssignedCompositeNewElementPosition.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v which is assigning a map/struct/slice to %v in %v",
+						options["functionName"], options["variableName"], file),
+				)
+			case GoSelectBeforeCallingStatementPosition.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v which is calling %v in %v",
+						options["functionName"], options["callName"], file),
+				)
+			case GoSelectNewCaseClausePosition.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v with a switch statement in %v",
+						options["functionName"], file),
+				)
+			case GoSelectFunctionParameterNames.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v with a named parameter of type %v in %v",
+						options["functionName"], options["typeName"], file),
+				)
+			case GoSelectReturnedValues.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v returning values in %v", options["functionName"], file),
+				)
+			case GoSelectKeyValueElementValues.id:
+				missingSelections = append(
+					missingSelections,
+					fmt.Sprintf("◦ cannot find function %v with a %v element in %v",
+						options["functionName"], options["key"], file),
+				)
 			}
 		}
 	}
@@ -244,3 +292,189 @@ func (c *Clipper) PasteGoReturningCompositeNewArgumentSnippetAt(
 		},
 	)
 }
+
+// PasteGoFunctionNewParameterSnippetAt pastes a parameter at the end of the parameters of a function declaration.
+func (c *Clipper) PasteGoFunctionNewParameterSnippetAt(
+	path, code string, snippet string, options SelectOptions,
+) (string, error) {
+	return c.PasteGeneratedCodeSnippetAt(
+		path,
+		code,
+		GoSelectFunctionNewParameterPosition,
+		options,
+		func(data interface{}) string {
+			d := data.(GoFunctionNewParameterPositionData)
+			return goListItemSnippet(snippet, d.HasParameters, d.HasTrailingComma)
+		},
+	)
+}
+
+// PasteGoFunctionCallNewArgumentSnippetAt pastes an argument at the end of the arguments of a function call within
+// a function.
+func (c *Clipper) PasteGoFunctionCallNewArgumentSnippetAt(
+	path, code string, snippet string, options SelectOptions,
+) (string, error) {
+	return c.PasteGeneratedCodeSnippetAt(
+		path,
+		code,
+		GoSelectFunctionCallNewArgumentPosition,
+		options,
+		func(data interface{}) string {
+			d := data.(GoFunctionCallNewArgumentPositionData)
+			return goListItemSnippet(snippet, d.HasArguments, d.HasTrailingComma)
+		},
+	)
+}
+
+// PasteGoAssignedCompositeNewElementSnippetAt pastes an element at the end of a struct/map/slice assigned to
+// a variable within a function.
+func (c *Clipper) PasteGoAssignedCompositeNewElementSnippetAt(
+	path, code string, snippet string, options SelectOptions,
+) (string, error) {
+	return c.PasteGeneratedCodeSnippetAt(
+		path,
+		code,
+		GoSelectAssignedCompositeNewElementPosition,
+		options,
+		func(data interface{}) string {
+			d := data.(GoCompositeNewElementPositionData)
+			return goListItemSnippet(snippet, d.HasElements, d.HasTrailingComma)
+		},
+	)
+}
+
+// goListItemSnippet formats a snippet to be pasted as the last item of a comma separated list. A list with a trailing
+// comma is expected to have its closing token on a new line.
+func goListItemSnippet(snippet string, hasItems, hasTrailingComma bool) string {
+	switch {
+	case !hasItems:
+		return snippet
+	case hasTrailingComma:
+		return fmt.Sprintf("%v,\n", snippet)
+	default:
+		return fmt.Sprintf(", %v", snippet)
+	}
+}
+
+// ReplaceCodeSnippetsAt replaces the code ranges pointed by the selector with the snippets generated from the code of
+// each range and returns a new code. The path is only used for context in errors.
+func (c *Clipper) ReplaceCodeSnippetsAt(
+	path, code string, selector *RangeSelector, options SelectOptions, generator SnippetGenerator,
+) (string, error) {
+	result, err := selector.call(path, code, options)
+	if err != nil {
+		return "", err
+	}
+
+	if len(result.Ranges) == 0 {
+		// Do nothing and return the code as is. The errors are accumulated by the clipper.
+		c.missingSelections = append(c.missingSelections, selector.id)
+		c.missingSelectionFiles = append(c.missingSelectionFiles, path)
+		c.missingSelectionOptions = append(c.missingSelectionOptions, options)
+		return code, nil
+	}
+
+	ranges := make([]OffsetRange, len(result.Ranges))
+	copy(ranges, result.Ranges)
+
+	// Replace the ranges from the end of the code so that the offsets of the remaining ones stay valid.
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start > ranges[j].Start
+	})
+
+	newContent := code
+	lastStart := OffsetPosition(len(code))
+	for _, r := range ranges {
+		// Skip the ranges which overlap with an already replaced range.
+		if r.End > lastStart {
+			continue
+		}
+		lastStart = r.Start
+
+		snippet := generator(newContent[r.Start:r.End])
+		newContent = newContent[:r.Start] + snippet + newContent[r.End:]
+	}
+
+	return newContent, nil
+}
+
+// CutCodeSnippetsAt removes the code ranges pointed by the selector and returns a new code. A range that occupies
+// whole lines is removed along with its indentation and line break. Nothing is removed when the selector doesn't
+// find any range since the snippets might have already been removed. The path is only used for context in errors.
+func (c *Clipper) CutCodeSnippetsAt(
+	path, code string, selector *RangeSelector, options SelectOptions,
+) (string, error) {
+	result, err := selector.call(path, code, options)
+	if err != nil {
+		return "", err
+	}
+
+	ranges := make([]OffsetRange, 0, len(result.Ranges))
+	for _, r := range result.Ranges {
+		ranges = append(ranges, expandRangeToLines(code, r))
+	}
+
+	// Cut the ranges from the end of the code so that the offsets of the remaining ones stay valid.
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start > ranges[j].Start
+	})
+
+	newContent := code
+	lastStart := OffsetPosition(len(code))
+	for _, r := range ranges {
+		// Skip the ranges which overlap with an already cut range.
+		if r.End > lastStart {
+			continue
+		}
+		lastStart = r.Start
+
+		newContent = trimEmptyLines(newContent[:r.Start], newContent[r.End:])
+	}
+
+	return newContent, nil
+}
+
+// expandRangeToLines expands a range to the whole lines it occupies if there is nothing else on these lines but
+// whitespaces.
+func expandRangeToLines(code string, r OffsetRange) OffsetRange {
+	start := int(r.Start)
+	for start > 0 && (code[start-1] == ' ' || code[start-1] == '\t') {
+		start--
+	}
+	if start != 0 && code[start-1] != '\n' {
+		return r
+	}
+
+	end := int(r.End)
+	for end < len(code) && (code[end] == ' ' || code[end] == '\t') {
+		end++
+	}
+	switch {
+	case end == len(code):
+	case code[end] == '\n':
+		end++
+	default:
+		return r
+	}
+
+	return OffsetRange{Start: OffsetPosition(start), End: OffsetPosition(end)}
+}
+
+// trimEmptyLines joins two parts of code while removing the empty line left between them if it follows another empty
+// line or the start of a block, or if it precedes the end of a block.
+func trimEmptyLines(before, after string) string {
+	if strings.HasPrefix(after, "\n") {
+		if strings.HasSuffix(before, "\n\n") || strings.HasSuffix(before, "{\n") || strings.HasSuffix(before, "(\n") {
+			after = after[1:]
+		}
+	}
+
+	if strings.HasSuffix(before, "\n\n") {
+		nextLine := strings.TrimLeft(after, " \t")
+		if nextLine == "" || strings.HasPrefix(nextLine, "}") || strings.HasPrefix(nextLine, ")") {
+			before = before[:len(before)-1]
+		}
+	}
+
+	return before + after
+}
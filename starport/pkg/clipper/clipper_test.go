@@ -1,6 +1,7 @@
 package clipper
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -356,3 +357,343 @@ func withNoReturn() int {
 		t.Fatal("incorrect generation: \n", generated)
 	}
 }
+
+const scaffoldedGoFile = `package test
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	PostKey      = "Post-value-"
+	PostCountKey = "Post-count-"
+)
+
+const (
+	CommentKey = "Comment-value-"
+	PostIDKey  = "Post-id-"
+)
+
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PostList:    []Post{},
+		CommentList: []Comment{},
+	}
+}
+
+func Validate(gs GenesisState) error {
+	// Check for duplicated id in post
+	postIdMap := make(map[uint64]bool)
+	for _, elem := range gs.PostList {
+		if _, ok := postIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for post")
+		}
+		postIdMap[elem.Id] = true
+	}
+
+	commentNames := strings.Join(gs.CommentNames, ",")
+	return nil
+}
+
+func Handle(msg interface{}) error {
+	switch msg := msg.(type) {
+	case *MsgCreatePost:
+		return nil
+	case *MsgCreateComment:
+		return nil
+	}
+	return nil
+}
+`
+
+func TestCuttingGoSnippets(t *testing.T) {
+	clip := New()
+	options := SelectOptions{
+		"names": "Post,PostList,PostKey,PostCountKey,PostIDKey,postIdMap,MsgCreatePost",
+	}
+	code := scaffoldedGoFile
+
+	var err error
+	for _, cut := range []struct {
+		selector *RangeSelector
+		function string
+	}{
+		{GoSelectDeclarationsReferencing, ""},
+		{GoSelectCompositeElementsReferencing, "DefaultGenesis"},
+		{GoSelectStatementsReferencing, "Validate"},
+		{GoSelectCaseClausesReferencing, "Handle"},
+		{GoSelectUnusedImports, ""},
+	} {
+		options["functionName"] = cut.function
+		code, err = clip.CutCodeSnippetsAt("test.go", code, cut.selector, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	correct := `package test
+
+import (
+	"strings"
+)
+
+const (
+	CommentKey = "Comment-value-"
+)
+
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		CommentList: []Comment{},
+	}
+}
+
+func Validate(gs GenesisState) error {
+	commentNames := strings.Join(gs.CommentNames, ",")
+	return nil
+}
+
+func Handle(msg interface{}) error {
+	switch msg := msg.(type) {
+	case *MsgCreateComment:
+		return nil
+	}
+	return nil
+}
+`
+
+	if code != correct {
+		t.Fatal("incorrect cut: \n", code)
+	}
+}
+
+const scaffoldedProtoFile = `syntax = "proto3";
+package cosmonaut.mars.mars;
+
+import "gogoproto/gogo.proto";
+import "mars/post.proto";
+import "mars/comment.proto";
+
+option go_package = "github.com/cosmonaut/mars/x/mars/types";
+
+// Query defines the gRPC query service.
+service Query {
+  // Queries a post by id.
+  rpc Post(QueryGetPostRequest) returns (QueryGetPostResponse);
+
+  // Queries a comment by id.
+  rpc Comment(QueryGetCommentRequest) returns (QueryGetCommentResponse);
+}
+
+message GenesisState {
+  repeated Post postList = 1 [(gogoproto.nullable) = false];
+  uint64 postCount = 2;
+  repeated Comment commentList = 3 [(gogoproto.nullable) = false];
+}
+
+message QueryGetPostRequest {
+  uint64 id = 1;
+}
+
+message QueryGetPostResponse {
+  Post Post = 1 [(gogoproto.nullable) = false];
+}
+
+message QueryGetCommentRequest {
+  uint64 id = 1;
+}`
+
+func TestCuttingProtoSnippets(t *testing.T) {
+	clip := New()
+	code := scaffoldedProtoFile
+
+	var err error
+	for _, cut := range []struct {
+		selector *RangeSelector
+		options  SelectOptions
+	}{
+		{ProtoSelectImports, SelectOptions{"paths": "mars/post.proto"}},
+		{ProtoSelectServiceMethods, SelectOptions{"name": "Query", "methods": "Post"}},
+		{ProtoSelectMessageFields, SelectOptions{"name": "GenesisState", "fields": "postList,postCount"}},
+		{ProtoSelectMessages, SelectOptions{"names": "QueryGetPostRequest,QueryGetPostResponse"}},
+	} {
+		code, err = clip.CutCodeSnippetsAt("test.proto", code, cut.selector, cut.options)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	correct := `syntax = "proto3";
+package cosmonaut.mars.mars;
+
+import "gogoproto/gogo.proto";
+import "mars/comment.proto";
+
+option go_package = "github.com/cosmonaut/mars/x/mars/types";
+
+// Query defines the gRPC query service.
+service Query {
+  // Queries a comment by id.
+  rpc Comment(QueryGetCommentRequest) returns (QueryGetCommentResponse);
+}
+
+message GenesisState {
+  repeated Comment commentList = 3 [(gogoproto.nullable) = false];
+}
+
+message QueryGetCommentRequest {
+  uint64 id = 1;
+}`
+
+	if code != correct {
+		t.Fatal("incorrect cut: \n", code)
+	}
+}
+
+const scaffoldedCommandGoFile = `package test
+
+func NewMsgCreatePost(creator string, title string) *MsgCreatePost {
+	return &MsgCreatePost{
+		Creator: creator,
+		Title:   title,
+	}
+}
+
+func CmdCreatePost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "create-post [title]",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTitle := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePost(clientCtx.GetFromAddress().String(), argTitle)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	return cmd
+}
+
+func TestCreatePost(t *testing.T) {
+	fields := []string{"xyz"}
+	args := []string{}
+	args = append(args, fields...)
+}
+`
+
+func TestAddingFieldToScaffoldedCode(t *testing.T) {
+	clip := New()
+	code := scaffoldedCommandGoFile
+
+	var err error
+	code, err = clip.PasteGoFunctionNewParameterSnippetAt("test.go", code, "body string", SelectOptions{
+		"functionName": "NewMsgCreatePost",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt("test.go", code, "Body: body", SelectOptions{
+		"functionName": "NewMsgCreatePost",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err = clip.ReplaceCodeSnippetsAt("test.go", code, GoSelectKeyValueElementValues, SelectOptions{
+		"functionName": "CmdCreatePost",
+		"key":          "Args",
+	}, func(data interface{}) string {
+		return strings.Replace(data.(string), "1", "2", 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err = clip.PasteCodeSnippetAt("test.go", code, GoSelectBeforeCallingStatementPosition, SelectOptions{
+		"functionName": "CmdCreatePost",
+		"callName":     "client.GetClientTxContext",
+	}, "argBody := args[1]\n\n\t\t\t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err = clip.PasteGoFunctionCallNewArgumentSnippetAt("test.go", code, "argBody", SelectOptions{
+		"functionName": "CmdCreatePost",
+		"callName":     "types.NewMsgCreatePost",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err = clip.PasteGoAssignedCompositeNewElementSnippetAt("test.go", code, `"abc"`, SelectOptions{
+		"functionName": "TestCreatePost",
+		"variableName": "fields",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := clip.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	correct := `package test
+
+func NewMsgCreatePost(creator string, title string, body string) *MsgCreatePost {
+	return &MsgCreatePost{
+		Creator: creator,
+		Title:   title,
+		Body: body,
+	}
+}
+
+func CmdCreatePost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "create-post [title]",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTitle := args[0]
+
+			argBody := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePost(clientCtx.GetFromAddress().String(), argTitle, argBody)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	return cmd
+}
+
+func TestCreatePost(t *testing.T) {
+	fields := []string{"xyz", "abc"}
+	args := []string{}
+	args = append(args, fields...)
+}
+`
+
+	if code != correct {
+		t.Fatal("incorrect generation: \n", code)
+	}
+}
+
+func TestReplacingMissingSnippet(t *testing.T) {
+	clip := New()
+	code, err := clip.ReplaceCodeSnippetsAt("test.go", scaffoldedCommandGoFile, GoSelectKeyValueElementValues, SelectOptions{
+		"functionName": "CmdCreatePost",
+		"key":          "Short",
+	}, func(data interface{}) string {
+		return data.(string)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != scaffoldedCommandGoFile {
+		t.Fatal("code modified without selection: \n", code)
+	}
+	if clip.Err() == nil {
+		t.Fatal("missing selection not reported")
+	}
+}
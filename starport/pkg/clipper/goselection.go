@@ -4,6 +4,9 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"path"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -32,6 +35,27 @@ type GoReturningCompositeNewArgumentPositionData struct {
 	HasTrailingComma bool
 }
 
+// GoFunctionNewParameterPositionData stores data collected during a selection of the position for a new parameter
+// in a function declaration.
+type GoFunctionNewParameterPositionData struct {
+	HasParameters    bool
+	HasTrailingComma bool
+}
+
+// GoFunctionCallNewArgumentPositionData stores data collected during a selection of the position for a new argument
+// in a function call.
+type GoFunctionCallNewArgumentPositionData struct {
+	HasArguments     bool
+	HasTrailingComma bool
+}
+
+// GoCompositeNewElementPositionData stores data collected during a selection of the position for a new element in
+// a struct/map/slice assigned to a variable.
+type GoCompositeNewElementPositionData struct {
+	HasElements      bool
+	HasTrailingComma bool
+}
+
 // goPositionFinder tries to find a required position during a walk of the Golang AST.
 type goPositionFinder func(result *PositionSelectorResult, options SelectOptions, code string) goVisitor
 
@@ -127,15 +151,43 @@ var GoSelectNewGlobalPosition = wrapGoFinder(
 	},
 )
 
+// goIsMethodOf checks if the function declaration is a method of the receiver type, pointer or not.
+// Any function declaration matches when no receiver type is given.
+func goIsMethodOf(function *ast.FuncDecl, receiverType string) bool {
+	if receiverType == "" {
+		return true
+	}
+	if function.Recv == nil || len(function.Recv.List) == 0 {
+		return false
+	}
+	recv := function.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	ident, ok := recv.(*ast.Ident)
+	return ok && ident.Name == receiverType
+}
+
 // GoSelectBeforeFunctionReturnsPosition selects a position just before the last function return (implicit or explicit).
 // This only considers the function return which is at the function return.
+// The optional receiverType option restricts the selection to the method of this type.
 var GoSelectBeforeFunctionReturnsPosition = wrapGoFinder(
 	func(result *PositionSelectorResult, options SelectOptions, _ string) goVisitor {
 		functionName := options["functionName"]
+		receiverType := options["receiverType"]
 
 		return func(node ast.Node) bool {
 			// Select a position after the package declaration or all the imports.
-			if n, ok := node.(*ast.FuncDecl); ok && n.Name.Name == functionName {
+			if n, ok := node.(*ast.FuncDecl); ok && n.Name.Name == functionName && goIsMethodOf(n, receiverType) {
+				if len(n.Body.List) == 0 {
+					// Select the position after the left brace as the function is empty.
+					result.OffsetPosition = OffsetPosition(n.Body.Lbrace + 1)
+					result.Data = GoBeforeFunctionReturnsPositionData{
+						HasReturn: false,
+					}
+					return true
+				}
+
 				lastItem := n.Body.List[len(n.Body.List)-1]
 
 				switch l := lastItem.(type) {
@@ -269,3 +321,561 @@ var GoSelectStructNewFieldPosition = wrapGoFinder(
 		}
 	},
 )
+
+// goCode gets the code of a node.
+func goCode(code string, node ast.Node) string {
+	// The positions coming from the AST are 1-indexed. So making them 0-indexed.
+	return code[node.Pos()-1 : node.End()-1]
+}
+
+// goHasTrailingComma checks if the closing token at the position is preceded by a comma.
+func goHasTrailingComma(code string, closing token.Pos) bool {
+	// TODO: This won't work if there is a comment after the comma.
+	return strings.HasSuffix(strings.TrimSpace(code[:closing-1]), ",")
+}
+
+// GoSelectFunctionNewParameterPosition selects a position for a new parameter at the end of the parameters of a
+// function declaration.
+var GoSelectFunctionNewParameterPosition = wrapGoFinder(
+	func(result *PositionSelectorResult, options SelectOptions, code string) goVisitor {
+		functionName := options["functionName"]
+
+		return func(node ast.Node) bool {
+			if n, ok := node.(*ast.FuncDecl); ok && n.Name.Name == functionName {
+				params := n.Type.Params
+				result.OffsetPosition = OffsetPosition(params.Closing)
+				result.Data = GoFunctionNewParameterPositionData{
+					HasParameters:    params.NumFields() != 0,
+					HasTrailingComma: goHasTrailingComma(code, params.Closing),
+				}
+			}
+
+			return true
+		}
+	},
+)
+
+// GoSelectFunctionCallNewArgumentPosition selects a position for a new argument at the end of the arguments of
+// the first call to callName within a function. callName is the called function as written in the code,
+// e.g. "types.NewMsgCreatePost".
+var GoSelectFunctionCallNewArgumentPosition = wrapGoFinder(
+	func(result *PositionSelectorResult, options SelectOptions, code string) goVisitor {
+		functionName := options["functionName"]
+		callName := options["callName"]
+
+		return func(node ast.Node) bool {
+			n, ok := node.(*ast.FuncDecl)
+			if !ok || n.Name.Name != functionName || n.Body == nil {
+				return true
+			}
+
+			ast.Inspect(n.Body, func(node ast.Node) bool {
+				if result.OffsetPosition != NoOffsetPosition {
+					return false
+				}
+
+				if call, ok := node.(*ast.CallExpr); ok && goCode(code, call.Fun) == callName {
+					result.OffsetPosition = OffsetPosition(call.Rparen)
+					result.Data = GoFunctionCallNewArgumentPositionData{
+						HasArguments:     len(call.Args) != 0,
+						HasTrailingComma: goHasTrailingComma(code, call.Rparen),
+					}
+					return false
+				}
+				return true
+			})
+			return false
+		}
+	},
+)
+
+// GoSelectAssignedCompositeNewElementPosition selects a position for a new element in the struct/map/slice which is
+// assigned to a variable within a function.
+var GoSelectAssignedCompositeNewElementPosition = wrapGoFinder(
+	func(result *PositionSelectorResult, options SelectOptions, code string) goVisitor {
+		functionName := options["functionName"]
+		variableName := options["variableName"]
+
+		selectComposite := func(value ast.Expr) {
+			// If the assigned value is a reference, cut the reference symbol off.
+			if v, ok := value.(*ast.UnaryExpr); ok && v.Op == token.AND {
+				value = v.X
+			}
+
+			if v, ok := value.(*ast.CompositeLit); ok && result.OffsetPosition == NoOffsetPosition {
+				result.OffsetPosition = OffsetPosition(v.Rbrace)
+				result.Data = GoCompositeNewElementPositionData{
+					HasElements:      len(v.Elts) != 0,
+					HasTrailingComma: goHasTrailingComma(code, v.Rbrace),
+				}
+			}
+		}
+
+		return func(node ast.Node) bool {
+			n, ok := node.(*ast.FuncDecl)
+			if !ok || n.Name.Name != functionName || n.Body == nil {
+				return true
+			}
+
+			ast.Inspect(n.Body, func(node ast.Node) bool {
+				switch s := node.(type) {
+				case *ast.AssignStmt:
+					for i, lhs := range s.Lhs {
+						if ident, ok := lhs.(*ast.Ident); ok && ident.Name == variableName && i < len(s.Rhs) {
+							selectComposite(s.Rhs[i])
+						}
+					}
+				case *ast.ValueSpec:
+					for i, name := range s.Names {
+						if name.Name == variableName && i < len(s.Values) {
+							selectComposite(s.Values[i])
+						}
+					}
+				}
+				return true
+			})
+			return false
+		}
+	},
+)
+
+// GoSelectBeforeCallingStatementPosition selects a position just before the innermost statement of a function which
+// calls callName. callName is the called function as written in the code, e.g. "client.GetClientTxContext".
+var GoSelectBeforeCallingStatementPosition = wrapGoFinder(
+	func(result *PositionSelectorResult, options SelectOptions, code string) goVisitor {
+		functionName := options["functionName"]
+		callName := options["callName"]
+
+		calls := func(stmt ast.Stmt) (found bool) {
+			ast.Inspect(stmt, func(node ast.Node) bool {
+				if call, ok := node.(*ast.CallExpr); ok && goCode(code, call.Fun) == callName {
+					found = true
+				}
+				return !found
+			})
+			return found
+		}
+
+		return func(node ast.Node) bool {
+			n, ok := node.(*ast.FuncDecl)
+			if !ok || n.Name.Name != functionName || n.Body == nil {
+				return true
+			}
+
+			// The innermost statement is the one starting the latest among the statements calling the function.
+			var selected ast.Stmt
+			ast.Inspect(n.Body, func(node ast.Node) bool {
+				block, ok := node.(*ast.BlockStmt)
+				if !ok {
+					return true
+				}
+				for _, stmt := range block.List {
+					if calls(stmt) && (selected == nil || stmt.Pos() > selected.Pos()) {
+						selected = stmt
+					}
+				}
+				return true
+			})
+			if selected != nil {
+				result.OffsetPosition = OffsetPosition(selected.Pos())
+			}
+			return false
+		}
+	},
+)
+
+// GoSelectNewCaseClausePosition selects a position for a new case clause in the first switch of a function.
+// The position is just before the default clause or at the end of the switch if there is no default clause.
+var GoSelectNewCaseClausePosition = wrapGoFinder(
+	func(result *PositionSelectorResult, options SelectOptions, _ string) goVisitor {
+		functionName := options["functionName"]
+
+		return func(node ast.Node) bool {
+			n, ok := node.(*ast.FuncDecl)
+			if !ok || n.Name.Name != functionName || n.Body == nil {
+				return true
+			}
+
+			ast.Inspect(n.Body, func(node ast.Node) bool {
+				if result.OffsetPosition != NoOffsetPosition {
+					return false
+				}
+
+				var body *ast.BlockStmt
+				switch s := node.(type) {
+				case *ast.SwitchStmt:
+					body = s.Body
+				case *ast.TypeSwitchStmt:
+					body = s.Body
+				default:
+					return true
+				}
+
+				result.OffsetPosition = OffsetPosition(body.Rbrace)
+				for _, stmt := range body.List {
+					if clause, ok := stmt.(*ast.CaseClause); ok && clause.List == nil {
+						result.OffsetPosition = OffsetPosition(clause.Pos())
+					}
+				}
+				return false
+			})
+			return false
+		}
+	},
+)
+
+// goRangeFinder tries to find the ranges to select in the Golang AST.
+type goRangeFinder func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string)
+
+// wrapGoRangeFinder creates a range selector out of each finder.
+func wrapGoRangeFinder(finder goRangeFinder) *RangeSelector {
+	positionSelectorID += 1
+	return &RangeSelector{
+		id: positionSelectorID,
+		call: func(path, code string, options SelectOptions) (*RangeSelectorResult, error) {
+			fileSet := token.NewFileSet()
+			parsedAST, err := parser.ParseFile(fileSet, path, []byte(code), parser.ParseComments)
+			if err != nil {
+				return nil, err
+			}
+
+			if options == nil {
+				options = SelectOptions{}
+			}
+
+			result := &RangeSelectorResult{}
+			finder(result, options, fileSet, parsedAST, code)
+			return result, nil
+		},
+	}
+}
+
+// goNodeRange gets the range of a node including the comments right above it and its trailing comma if any.
+func goNodeRange(fileSet *token.FileSet, file *ast.File, code string, node ast.Node) OffsetRange {
+	// The positions coming from the AST are 1-indexed. So making them 0-indexed.
+	start := node.Pos()
+	for i := len(file.Comments) - 1; i >= 0; i-- {
+		comment := file.Comments[i]
+		if comment.End() > start {
+			continue
+		}
+		if fileSet.Position(comment.End()).Line != fileSet.Position(start).Line-1 {
+			break
+		}
+
+		// The comment must be alone on its line so that it's not a trailing comment of a previous node.
+		lineStart := strings.LastIndex(code[:comment.Pos()-1], "\n") + 1
+		if strings.TrimSpace(code[lineStart:comment.Pos()-1]) != "" {
+			break
+		}
+		start = comment.Pos()
+	}
+
+	end := int(node.End()) - 1
+	rightPart := strings.TrimLeft(code[end:], " \t")
+	if strings.HasPrefix(rightPart, ",") {
+		end = len(code) - len(rightPart) + 1
+	}
+
+	return OffsetRange{
+		Start: OffsetPosition(start - 1),
+		End:   OffsetPosition(end),
+	}
+}
+
+// goReferences checks if any of the identifiers within the node is one of the names.
+func goReferences(node ast.Node, names map[string]struct{}) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			if _, ok := names[ident.Name]; ok {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// goFindFunction finds the function declaration with the name.
+func goFindFunction(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if f, ok := decl.(*ast.FuncDecl); ok && f.Name.Name == name && f.Body != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// splitOption splits a comma separated option into a set of values.
+func splitOption(options SelectOptions, key string) map[string]struct{} {
+	values := make(map[string]struct{})
+	for _, value := range strings.Split(options[key], ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values[value] = struct{}{}
+		}
+	}
+	return values
+}
+
+// GoSelectStatementsReferencing selects the statements of a function body which reference any of the names. The
+// names are separated by commas.
+var GoSelectStatementsReferencing = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		names := splitOption(options, "names")
+
+		function := goFindFunction(file, options["functionName"])
+		if function == nil {
+			return
+		}
+
+		for _, stmt := range function.Body.List {
+			if goReferences(stmt, names) {
+				result.Ranges = append(result.Ranges, goNodeRange(fileSet, file, code, stmt))
+			}
+		}
+	},
+)
+
+// GoSelectCaseClausesReferencing selects the case clauses within a function which have an expression referencing
+// any of the names. The names are separated by commas.
+var GoSelectCaseClausesReferencing = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		names := splitOption(options, "names")
+
+		function := goFindFunction(file, options["functionName"])
+		if function == nil {
+			return
+		}
+
+		ast.Inspect(function.Body, func(node ast.Node) bool {
+			clause, ok := node.(*ast.CaseClause)
+			if !ok {
+				return true
+			}
+
+			for _, expr := range clause.List {
+				if goReferences(expr, names) {
+					result.Ranges = append(result.Ranges, goNodeRange(fileSet, file, code, clause))
+					return false
+				}
+			}
+			return true
+		})
+	},
+)
+
+// GoSelectCompositeElementsReferencing selects the elements of the maps/structs/slices within a function which
+// reference any of the names. Only the outermost elements are selected. The names are separated by commas.
+var GoSelectCompositeElementsReferencing = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		names := splitOption(options, "names")
+
+		function := goFindFunction(file, options["functionName"])
+		if function == nil {
+			return
+		}
+
+		var selectElements func(node ast.Node) bool
+		selectElements = func(node ast.Node) bool {
+			composite, ok := node.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+
+			for _, elt := range composite.Elts {
+				if goReferences(elt, names) {
+					result.Ranges = append(result.Ranges, goNodeRange(fileSet, file, code, elt))
+				} else {
+					// Look for selections in the nested elements.
+					ast.Inspect(elt, selectElements)
+				}
+			}
+			return false
+		}
+		ast.Inspect(function.Body, selectElements)
+	},
+)
+
+// GoSelectDeclarationsReferencing selects the global constant, variable and type declarations which reference any
+// of the names. A grouped declaration is selected entirely only if all of its specs reference the names. The names are
+// separated by commas.
+var GoSelectDeclarationsReferencing = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		names := splitOption(options, "names")
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok == token.IMPORT {
+				continue
+			}
+
+			var selectedSpecs []ast.Spec
+			for _, spec := range genDecl.Specs {
+				if goReferences(spec, names) {
+					selectedSpecs = append(selectedSpecs, spec)
+				}
+			}
+
+			if len(selectedSpecs) == len(genDecl.Specs) {
+				result.Ranges = append(result.Ranges, goNodeRange(fileSet, file, code, genDecl))
+				continue
+			}
+			for _, spec := range selectedSpecs {
+				result.Ranges = append(result.Ranges, goNodeRange(fileSet, file, code, spec))
+			}
+		}
+	},
+)
+
+// goVersionSuffix matches the major version suffix of a module path.
+var goVersionSuffix = regexp.MustCompile(`^v[0-9]+$`)
+
+// goImportName gets the name of an imported package. The package name can't always be deduced from the import path
+// alone, in that case an empty name is returned.
+func goImportName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+
+	importPath, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return ""
+	}
+
+	name := path.Base(importPath)
+	if goVersionSuffix.MatchString(name) || !token.IsIdentifier(name) {
+		return ""
+	}
+	return name
+}
+
+// GoSelectUnusedImports selects the imports which are not used within the file. Imports with a package name that
+// can't be deduced from their path are never selected.
+var GoSelectUnusedImports = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		used := make(map[string]struct{})
+		ast.Inspect(file, func(node ast.Node) bool {
+			if s, ok := node.(*ast.SelectorExpr); ok {
+				if ident, ok := s.X.(*ast.Ident); ok {
+					used[ident.Name] = struct{}{}
+				}
+			}
+			return true
+		})
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.IMPORT {
+				continue
+			}
+
+			var unusedSpecs []ast.Spec
+			for _, spec := range genDecl.Specs {
+				name := goImportName(spec.(*ast.ImportSpec))
+				if name == "" || name == "_" || name == "." {
+					continue
+				}
+				if _, ok := used[name]; !ok {
+					unusedSpecs = append(unusedSpecs, spec)
+				}
+			}
+
+			if len(unusedSpecs) == len(genDecl.Specs) {
+				result.Ranges = append(result.Ranges, goNodeRange(fileSet, file, code, genDecl))
+				continue
+			}
+			for _, spec := range unusedSpecs {
+				result.Ranges = append(result.Ranges, goNodeRange(fileSet, file, code, spec))
+			}
+		}
+	},
+)
+
+// GoSelectFunctionParameterNames selects the names of the parameters of a function declaration which have the type
+// typeName as written in the code, e.g. "sdk.Context". The optional receiverType option restricts the selection to the
+// method of this type.
+var GoSelectFunctionParameterNames = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		functionName := options["functionName"]
+		receiverType := options["receiverType"]
+		typeName := options["typeName"]
+
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Name.Name != functionName || !goIsMethodOf(function, receiverType) {
+				continue
+			}
+
+			for _, param := range function.Type.Params.List {
+				if goCode(code, param.Type) != typeName {
+					continue
+				}
+				for _, name := range param.Names {
+					// The positions coming from the AST are 1-indexed. So making them 0-indexed.
+					result.Ranges = append(result.Ranges, OffsetRange{
+						Start: OffsetPosition(name.Pos() - 1),
+						End:   OffsetPosition(name.End() - 1),
+					})
+				}
+			}
+		}
+	},
+)
+
+// GoSelectReturnedValues selects the values returned by the last statement of a function when it is a return. The
+// optional receiverType option restricts the selection to the method of this type.
+var GoSelectReturnedValues = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		functionName := options["functionName"]
+		receiverType := options["receiverType"]
+
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Name.Name != functionName || !goIsMethodOf(function, receiverType) ||
+				function.Body == nil || len(function.Body.List) == 0 {
+				continue
+			}
+
+			ret, ok := function.Body.List[len(function.Body.List)-1].(*ast.ReturnStmt)
+			if !ok {
+				continue
+			}
+			for _, value := range ret.Results {
+				// The positions coming from the AST are 1-indexed. So making them 0-indexed.
+				result.Ranges = append(result.Ranges, OffsetRange{
+					Start: OffsetPosition(value.Pos() - 1),
+					End:   OffsetPosition(value.End() - 1),
+				})
+			}
+		}
+	},
+)
+
+// GoSelectKeyValueElementValues selects the values of the key-value elements with the key in the structs/maps within
+// a function.
+var GoSelectKeyValueElementValues = wrapGoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, fileSet *token.FileSet, file *ast.File, code string) {
+		key := options["key"]
+
+		function := goFindFunction(file, options["functionName"])
+		if function == nil {
+			return
+		}
+
+		ast.Inspect(function.Body, func(node ast.Node) bool {
+			kv, ok := node.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+
+			if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == key {
+				// The positions coming from the AST are 1-indexed. So making them 0-indexed.
+				result.Ranges = append(result.Ranges, OffsetRange{
+					Start: OffsetPosition(kv.Value.Pos() - 1),
+					End:   OffsetPosition(kv.Value.End() - 1),
+				})
+			}
+			return true
+		})
+	},
+)
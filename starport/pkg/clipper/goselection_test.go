@@ -1,6 +1,9 @@
 package clipper
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 const noImportGoFile = `package test
 
@@ -137,6 +140,31 @@ func TestGoSelectBeforeFunctionReturnsPositionWithNoReturn(t *testing.T) {
 	}
 }
 
+const methodsReturnGoFile = `package rets
+
+func (a A) validate() error {
+	return nil
+}
+
+func (b *B) validate() error {
+	return nil
+}
+`
+
+func TestGoSelectBeforeFunctionReturnsPositionOfMethod(t *testing.T) {
+	result, err := GoSelectBeforeFunctionReturnsPosition.call("test.go", methodsReturnGoFile, SelectOptions{
+		"functionName": "validate",
+		"receiverType": "A",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.OffsetPosition != 45 {
+		t.Fatal("invalid new position before return", result)
+	}
+}
+
 func TestGoSelectStartOfFunctionPosition(t *testing.T) {
 	result, err := GoSelectStartOfFunctionPosition.call("test.go", noImportGoFile, SelectOptions{
 		"functionName": "main",
@@ -386,3 +414,191 @@ func TestGoSelectStructNewFieldPosition(t *testing.T) {
 		t.Fatal("invalid struct new field position", result)
 	}
 }
+
+const compositeTestCasesGoFile = `package test
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		state *GenesisState
+	}{
+		{
+			desc:  "valid",
+			state: &GenesisState{CommentList: []Comment{}},
+		},
+		{
+			desc:  "duplicated post",
+			state: &GenesisState{PostList: []Post{{Id: 0}, {Id: 0}}},
+		},
+	} {
+	}
+}
+`
+
+func TestGoSelectCompositeElementsReferencingOutermost(t *testing.T) {
+	result, err := GoSelectCompositeElementsReferencing.call("test.go", compositeTestCasesGoFile, SelectOptions{
+		"functionName": "TestValidate",
+		"names":        "PostList",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Ranges) != 1 {
+		t.Fatal("invalid number of selected elements", result)
+	}
+
+	selected := compositeTestCasesGoFile[result.Ranges[0].Start:result.Ranges[0].End]
+	if !strings.HasPrefix(selected, "{\n\t\t\tdesc:  \"duplicated post\"") || !strings.HasSuffix(selected, "},") {
+		t.Fatal("invalid selected element", selected)
+	}
+}
+
+const unusedImportsGoFile = `package test
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spm/v2"
+)
+
+func main() {
+	fmt.Println()
+}
+`
+
+func TestGoSelectUnusedImports(t *testing.T) {
+	result, err := GoSelectUnusedImports.call("test.go", unusedImportsGoFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var selected []string
+	for _, r := range result.Ranges {
+		selected = append(selected, unusedImportsGoFile[r.Start:r.End])
+	}
+
+	// The versioned import is not selected as its package name can't be deduced.
+	if strings.Join(selected, ";") != `"strings";sdk "github.com/cosmos/cosmos-sdk/types"` {
+		t.Fatal("invalid selected imports", selected)
+	}
+}
+
+const assignedCompositeGoFile = `package keeper
+
+func (k msgServer) CreatePost(msg *types.MsgCreatePost) {
+	var post = types.Post{
+		Creator: msg.Creator,
+	}
+	k.SetPost(post)
+}
+`
+
+func TestGoSelectAssignedCompositeNewElementPosition(t *testing.T) {
+	result, err := GoSelectAssignedCompositeNewElementPosition.call("test.go", assignedCompositeGoFile, SelectOptions{
+		"functionName": "CreatePost",
+		"variableName": "post",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.OffsetPosition != 123 {
+		t.Fatal("invalid new element position", result)
+	}
+
+	data := result.Data.(GoCompositeNewElementPositionData)
+	if !data.HasElements || !data.HasTrailingComma {
+		t.Fatal("invalid new element data", result)
+	}
+}
+
+const emptyMethodGoFile = `package test
+
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+`
+
+func TestGoSelectBeforeFunctionReturnsPositionWhenEmpty(t *testing.T) {
+	result, err := GoSelectBeforeFunctionReturnsPosition.call("test.go", emptyMethodGoFile, SelectOptions{
+		"functionName": "BeginBlock",
+		"receiverType": "AppModule",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.OffsetPosition != 87 {
+		t.Fatal("invalid new position in empty function", result)
+	}
+}
+
+func TestGoSelectFunctionParameterNames(t *testing.T) {
+	result, err := GoSelectFunctionParameterNames.call("test.go", emptyMethodGoFile, SelectOptions{
+		"functionName": "BeginBlock",
+		"receiverType": "AppModule",
+		"typeName":     "sdk.Context",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Ranges) != 1 {
+		t.Fatal("invalid number of selected parameters", result)
+	}
+
+	r := result.Ranges[0]
+	if r.Start != 45 || emptyMethodGoFile[r.Start:r.End] != "_" {
+		t.Fatal("invalid selected parameter name", result)
+	}
+}
+
+const switchGoFile = `package test
+
+func NewHandler() {
+	switch c := content.(type) {
+	case *Foo:
+		return nil
+	default:
+		return err
+	}
+}
+`
+
+func TestGoSelectNewCaseClausePosition(t *testing.T) {
+	result, err := GoSelectNewCaseClausePosition.call("test.go", switchGoFile, SelectOptions{
+		"functionName": "NewHandler",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.OffsetPosition != 90 || !strings.HasPrefix(switchGoFile[result.OffsetPosition:], "default:") {
+		t.Fatal("invalid new case clause position", result)
+	}
+}
+
+const returningMethodGoFile = `package test
+
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+`
+
+func TestGoSelectReturnedValues(t *testing.T) {
+	result, err := GoSelectReturnedValues.call("test.go", returningMethodGoFile, SelectOptions{
+		"functionName": "ConsensusVersion",
+		"receiverType": "AppModule",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Ranges) != 1 {
+		t.Fatal("invalid number of selected values", result)
+	}
+
+	r := result.Ranges[0]
+	if returningMethodGoFile[r.Start:r.End] != "2" {
+		t.Fatal("invalid selected value", result)
+	}
+}
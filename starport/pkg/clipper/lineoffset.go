@@ -45,3 +45,30 @@ func offsetForProtoSourcePos(offsetMap lineOffsetMap, pos *ast.SourcePos) Offset
 
 	return OffsetPosition(offsetMap[pos.Line-1] + pos.Col)
 }
+
+// protoTabWidth is the width of the tab stops used by the protobuf parser to compute the columns.
+const protoTabWidth = 8
+
+// offsetForProtoSourcePosInCode converts the Proto's source position to an offset position. Unlike
+// offsetForProtoSourcePos, this takes into account the tab stops used by the parser when computing the columns.
+func offsetForProtoSourcePosInCode(code string, offsetMap lineOffsetMap, pos *ast.SourcePos) OffsetPosition {
+	if pos == nil {
+		return NoOffsetPosition
+	}
+
+	// The offset map points to the new line char preceding the line.
+	offset := 0
+	if pos.Line > 1 {
+		offset = offsetMap[pos.Line-1] + 1
+	}
+
+	for col := 1; col < pos.Col && offset < len(code); offset++ {
+		if code[offset] == '\t' {
+			col += protoTabWidth - (col-1)%protoTabWidth
+		} else {
+			col++
+		}
+	}
+
+	return OffsetPosition(offset)
+}
@@ -46,3 +46,22 @@ func TestCalcOffsetPositionForProtoSourcePosition(t *testing.T) {
 		t.Fatal("wrong offset position calculated", offset)
 	}
 }
+
+func TestOffsetForProtoSourcePosInCodeWithTabs(t *testing.T) {
+	code := "syntax = \"proto3\";\n\t\trpc\n  \tx"
+	offsetMap, err := lineOffsetMapOfFile(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The parser advances to the next tab stop for each tab.
+	if offset := offsetForProtoSourcePosInCode(code, offsetMap, &ast.SourcePos{Line: 2, Col: 17}); offset != 21 {
+		t.Fatal("invalid offset for the position after tabs", offset)
+	}
+	if offset := offsetForProtoSourcePosInCode(code, offsetMap, &ast.SourcePos{Line: 3, Col: 9}); offset != 28 {
+		t.Fatal("invalid offset for the position after spaces and tabs", offset)
+	}
+	if offset := offsetForProtoSourcePosInCode(code, offsetMap, &ast.SourcePos{Line: 1, Col: 1}); offset != 0 {
+		t.Fatal("invalid offset for the start of the code", offset)
+	}
+}
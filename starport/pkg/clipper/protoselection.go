@@ -1,6 +1,8 @@
 package clipper
 
 import (
+	"strings"
+
 	"github.com/jhump/protoreflect/desc/protoparse/ast"
 )
 
@@ -188,3 +190,145 @@ var ProtoSelectLastPosition = &PositionSelector{
 		return &result, nil
 	},
 }
+
+// protoRangeFinder tries to find the ranges to select during a walk of the protobuf AST.
+type protoRangeFinder func(result *RangeSelectorResult, options SelectOptions, code string, offsetMap lineOffsetMap) ast.VisitFunc
+
+// wrapProtoRangeFinder creates a range selector out of each finder.
+func wrapProtoRangeFinder(find protoRangeFinder) *RangeSelector {
+	positionSelectorID += 1
+
+	return &RangeSelector{
+		id: positionSelectorID,
+		call: func(path, code string, options SelectOptions) (*RangeSelectorResult, error) {
+			parsedAST, err := parseProto(path, code)
+			if err != nil {
+				return nil, err
+			}
+
+			offsetMap, err := lineOffsetMapOfFile(code)
+			if err != nil {
+				return nil, err
+			}
+
+			if options == nil {
+				options = SelectOptions{}
+			}
+
+			result := &RangeSelectorResult{}
+			ast.Walk(parsedAST, find(result, options, code, offsetMap))
+			return result, nil
+		},
+	}
+}
+
+// protoNodeRange gets the range of a node including the comments right above it.
+func protoNodeRange(code string, offsetMap lineOffsetMap, node ast.Node) OffsetRange {
+	start := node.Start()
+
+	comments := node.LeadingComments()
+	for i := len(comments) - 1; i >= 0; i-- {
+		comment := comments[i]
+		endLine := comment.Start.Line + strings.Count(strings.TrimSuffix(comment.Text, "\n"), "\n")
+		if endLine != start.Line-1 {
+			break
+		}
+		commentStart := comment.Start
+		start = &commentStart
+	}
+
+	return OffsetRange{
+		Start: offsetForProtoSourcePosInCode(code, offsetMap, start),
+		End:   offsetForProtoSourcePosInCode(code, offsetMap, node.End()),
+	}
+}
+
+// ProtoSelectMessages selects the messages with any of the names. The names are separated by commas.
+var ProtoSelectMessages = wrapProtoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, code string, offsetMap lineOffsetMap) ast.VisitFunc {
+		names := splitOption(options, "names")
+
+		return func(node ast.Node) (bool, ast.VisitFunc) {
+			if n, ok := node.(*ast.MessageNode); ok {
+				if _, ok := names[n.Name.Val]; ok {
+					result.Ranges = append(result.Ranges, protoNodeRange(code, offsetMap, n))
+					return false, nil
+				}
+			}
+
+			return true, nil
+		}
+	},
+)
+
+// ProtoSelectServiceMethods selects the methods of a service with any of the method names. The method names are
+// separated by commas.
+var ProtoSelectServiceMethods = wrapProtoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, code string, offsetMap lineOffsetMap) ast.VisitFunc {
+		methods := splitOption(options, "methods")
+
+		return func(node ast.Node) (bool, ast.VisitFunc) {
+			if n, ok := node.(*ast.ServiceNode); ok && n.Name.Val == options["name"] {
+				for _, decl := range n.Decls {
+					if rpc, ok := decl.(*ast.RPCNode); ok {
+						if _, ok := methods[rpc.Name.Val]; ok {
+							result.Ranges = append(result.Ranges, protoNodeRange(code, offsetMap, rpc))
+						}
+					}
+				}
+			}
+
+			return true, nil
+		}
+	},
+)
+
+// ProtoSelectMessageFields selects the fields of a message with any of the field names, this includes the fields
+// within the oneofs of the message. The field names are separated by commas.
+var ProtoSelectMessageFields = wrapProtoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, code string, offsetMap lineOffsetMap) ast.VisitFunc {
+		fields := splitOption(options, "fields")
+
+		selectField := func(field *ast.FieldNode) {
+			if _, ok := fields[field.Name.Val]; ok {
+				result.Ranges = append(result.Ranges, protoNodeRange(code, offsetMap, field))
+			}
+		}
+
+		return func(node ast.Node) (bool, ast.VisitFunc) {
+			if n, ok := node.(*ast.MessageNode); ok && n.Name.Val == options["name"] {
+				for _, decl := range n.Decls {
+					switch d := decl.(type) {
+					case *ast.FieldNode:
+						selectField(d)
+					case *ast.OneOfNode:
+						for _, oneOfDecl := range d.Decls {
+							if field, ok := oneOfDecl.(*ast.FieldNode); ok {
+								selectField(field)
+							}
+						}
+					}
+				}
+			}
+
+			return true, nil
+		}
+	},
+)
+
+// ProtoSelectImports selects the imports with any of the paths. The paths are separated by commas.
+var ProtoSelectImports = wrapProtoRangeFinder(
+	func(result *RangeSelectorResult, options SelectOptions, code string, offsetMap lineOffsetMap) ast.VisitFunc {
+		paths := splitOption(options, "paths")
+
+		return func(node ast.Node) (bool, ast.VisitFunc) {
+			if n, ok := node.(*ast.ImportNode); ok {
+				if _, ok := paths[n.Name.AsString()]; ok {
+					result.Ranges = append(result.Ranges, protoNodeRange(code, offsetMap, n))
+				}
+			}
+
+			return true, nil
+		}
+	},
+)
@@ -140,3 +140,22 @@ func TestProtoSelectLastPosition(t *testing.T) {
 		t.Fatal("wrong result found", result)
 	}
 }
+
+func TestProtoSelectMessageFieldsInOneOf(t *testing.T) {
+	result, err := ProtoSelectMessageFields.call("test.proto", packetProtoFile, SelectOptions{
+		"name":   "MarsPacketData",
+		"fields": "noData",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Ranges) != 1 {
+		t.Fatal("invalid number of selected fields", result)
+	}
+
+	selected := packetProtoFile[result.Ranges[0].Start:result.Ranges[0].End]
+	if selected != "NoData noData = 1;" {
+		t.Fatal("invalid selected field", selected)
+	}
+}
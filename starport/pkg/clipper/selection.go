@@ -24,3 +24,20 @@ type PositionSelector struct {
 	id   int
 	call func(path, code string, options SelectOptions) (*PositionSelectorResult, error)
 }
+
+// OffsetRange is a range of code from its base. Start is inclusive and End is exclusive.
+type OffsetRange struct {
+	Start OffsetPosition
+	End   OffsetPosition
+}
+
+// RangeSelectorResult contains the ranges in code after a successful selection.
+type RangeSelectorResult struct {
+	Ranges []OffsetRange
+}
+
+// RangeSelector is a configurable selector which can select ranges of code.
+type RangeSelector struct {
+	id   int
+	call func(path, code string, options SelectOptions) (*RangeSelectorResult, error)
+}
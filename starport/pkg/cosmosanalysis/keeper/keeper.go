@@ -0,0 +1,294 @@
+// Package keeper provides a toolset for statically analysing the usage of the keepers a Cosmos SDK module depends on
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+const (
+	appPkg           = "app"
+	newKeeperFunc    = "NewKeeper"
+	newAppModuleFunc = "NewAppModule"
+	typesPkg         = "types"
+	keeperPkg        = "keeper"
+	moduleDirectory  = "x"
+)
+
+// Method is a method of the keeper of a dependency called by the module.
+type Method struct {
+	// Name of the method.
+	Name string
+
+	// Signature of the method of the keeper given to the module by the app.
+	Signature *types.Signature
+}
+
+// Dependency is a keeper the module depends on through an interface of its types package.
+type Dependency struct {
+	// Interface is the name of the interface expected by the module, e.g. BankKeeper.
+	Interface string
+
+	// Keeper is the type of the keeper given to the module by the app.
+	Keeper types.Type
+
+	// Methods are the methods of the keeper called by the module, sorted by name.
+	Methods []Method
+}
+
+// Module keeps the keepers a module depends on.
+type Module struct {
+	// Types is the types package of the module declaring the expected keepers.
+	Types *types.Package
+
+	// Dependencies are the keepers the module depends on in the order of the parameters of its constructors.
+	Dependencies []Dependency
+}
+
+// Discover type checks the packages of a module and of the app to find the methods called by the module on the
+// keepers it depends on. The keepers are the parameters of the keeper and app module constructors of the module typed
+// with an interface of its types package, the methods are looked up on the keepers given to the constructors by the
+// app. The type errors of the module other than the calls of the methods not declared yet by the expected keepers are
+// returned.
+func Discover(ctx context.Context, appPath, goModulePath, moduleName string) (Module, error) {
+	var (
+		module        Module
+		modulePkgPath = path.Join(goModulePath, moduleDirectory, moduleName)
+		appPkgPath    = path.Join(goModulePath, appPkg)
+	)
+
+	pkgs, err := cosmosanalysis.ListPackages(ctx, appPath, appPkgPath, modulePkgPath+"/...")
+	if err != nil {
+		return module, err
+	}
+
+	// The packages of the module and of the app are type checked from source as the methods called on the
+	// expected keepers might not be defined yet, the other packages are imported from their export data
+	var (
+		fileSet = token.NewFileSet()
+		checked = make(map[string]*types.Package)
+		info    = &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Uses:  make(map[*ast.Ident]types.Object),
+		}
+		files    = make(map[string][]*ast.File)
+		errs     []types.Error
+		fromData = cosmosanalysis.NewExportDataImporter(fileSet, pkgs)
+		imp      = importerFunc(func(importPath string) (*types.Package, error) {
+			if pkg, ok := checked[importPath]; ok {
+				return pkg, nil
+			}
+			return fromData.Import(importPath)
+		})
+	)
+
+	// go list returns the dependencies before the packages depending on them
+	for _, pkg := range pkgs {
+		if pkg.ImportPath != appPkgPath && pkg.ImportPath != modulePkgPath &&
+			!strings.HasPrefix(pkg.ImportPath, modulePkgPath+"/") {
+			continue
+		}
+
+		for _, name := range pkg.GoFiles {
+			f, err := parser.ParseFile(fileSet, filepath.Join(pkg.Dir, name), nil, 0)
+			if err != nil {
+				return module, err
+			}
+			files[pkg.ImportPath] = append(files[pkg.ImportPath], f)
+		}
+
+		// The calls of the methods not defined yet by the expected keepers are errors, the errors of the module are
+		// kept to report the other ones once the calls are known. The errors of the app are ignored as the other
+		// modules of the app might not compile yet either.
+		isApp := pkg.ImportPath == appPkgPath
+		conf := types.Config{
+			Importer: imp,
+			Error: func(err error) {
+				if typeErr, ok := err.(types.Error); ok && !typeErr.Soft && !isApp {
+					errs = append(errs, typeErr)
+				}
+			},
+		}
+		checked[pkg.ImportPath], _ = conf.Check(pkg.ImportPath, fileSet, files[pkg.ImportPath], info)
+	}
+
+	module.Types = checked[path.Join(modulePkgPath, typesPkg)]
+	if module.Types == nil {
+		return module, fmt.Errorf("the types package of the module %s can't be found", moduleName)
+	}
+
+	// The expected keepers are the parameters of the constructors typed with an interface of the module
+	var (
+		constructors []*types.Func
+		dependencies = make(map[string]*Dependency)
+		order        []string
+	)
+	for _, c := range []struct {
+		importPath, name string
+	}{
+		{path.Join(modulePkgPath, keeperPkg), newKeeperFunc},
+		{modulePkgPath, newAppModuleFunc},
+	} {
+		pkg := checked[c.importPath]
+		if pkg == nil {
+			continue
+		}
+		constructor, ok := pkg.Scope().Lookup(c.name).(*types.Func)
+		if !ok {
+			continue
+		}
+		constructors = append(constructors, constructor)
+
+		params := constructor.Type().(*types.Signature).Params()
+		for i := 0; i < params.Len(); i++ {
+			name, ok := expectedKeeper(module.Types, params.At(i).Type())
+			if ok && dependencies[name] == nil {
+				dependencies[name] = &Dependency{Interface: name}
+				order = append(order, name)
+			}
+		}
+	}
+	if len(dependencies) == 0 {
+		return module, nil
+	}
+
+	// The keepers of the dependencies are the arguments given to the constructors by the app
+	for _, f := range files[appPkgPath] {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			for _, constructor := range constructors {
+				if info.Uses[calledIdent(call)] != constructor {
+					continue
+				}
+				params := constructor.Type().(*types.Signature).Params()
+				for i := 0; i < params.Len() && i < len(call.Args); i++ {
+					name, ok := expectedKeeper(module.Types, params.At(i).Type())
+					if !ok {
+						continue
+					}
+					if t := info.Types[call.Args[i]].Type; t != nil && !isNil(t) {
+						dependencies[name].Keeper = t
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	// The methods called are the selectors on values typed with an expected keeper
+	var (
+		methods = make(map[string]map[string]struct{})
+		calls   = make(map[token.Pos]struct{})
+	)
+	for importPath, pkgFiles := range files {
+		if importPath == appPkgPath {
+			continue
+		}
+		for _, f := range pkgFiles {
+			ast.Inspect(f, func(n ast.Node) bool {
+				selector, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				name, ok := expectedKeeper(module.Types, info.Types[selector.X].Type)
+				if !ok {
+					return true
+				}
+				if methods[name] == nil {
+					methods[name] = make(map[string]struct{})
+				}
+				methods[name][selector.Sel.Name] = struct{}{}
+				calls[selector.Sel.Pos()] = struct{}{}
+				return true
+			})
+		}
+	}
+
+	for _, err := range errs {
+		if _, ok := calls[err.Pos]; !ok {
+			return module, fmt.Errorf("the module %s can't be type checked: %w", moduleName, err)
+		}
+	}
+
+	for _, name := range order {
+		dependency := dependencies[name]
+		if dependency.Keeper == nil {
+			return module, fmt.Errorf("the keeper given to the module %s as %s can't be found in the app", moduleName, dependency.Interface)
+		}
+
+		var names []string
+		for name := range methods[dependency.Interface] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			obj, _, _ := types.LookupFieldOrMethod(dependency.Keeper, true, nil, name)
+			method, ok := obj.(*types.Func)
+			if !ok {
+				return module, fmt.Errorf("the keeper %s given to the module %s as %s doesn't have the method %s",
+					dependency.Keeper, moduleName, dependency.Interface, name)
+			}
+			dependency.Methods = append(dependency.Methods, Method{
+				Name:      name,
+				Signature: method.Type().(*types.Signature),
+			})
+		}
+		module.Dependencies = append(module.Dependencies, *dependency)
+	}
+
+	return module, nil
+}
+
+// expectedKeeper returns the name of the interface of the types package of the module typing a value.
+func expectedKeeper(typesPkg *types.Package, t types.Type) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() != typesPkg || !types.IsInterface(named) {
+		return "", false
+	}
+	return named.Obj().Name(), true
+}
+
+// calledIdent returns the identifier of the function called.
+func calledIdent(call *ast.CallExpr) *ast.Ident {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun
+	case *ast.SelectorExpr:
+		return fun.Sel
+	}
+	return nil
+}
+
+// isNil checks if a type is the type of the nil value.
+func isNil(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	return ok && basic.Kind() == types.UntypedNil
+}
+
+// importerFunc is a function implementing types.Importer.
+type importerFunc func(path string) (*types.Package, error)
+
+// Import imports a package.
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
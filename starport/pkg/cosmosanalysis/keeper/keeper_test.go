@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscover(t *testing.T) {
+	module, err := Discover(context.Background(), "testdata/planet", "github.com/tendermint/planet", "mars")
+	require.NoError(t, err)
+	require.Equal(t, "github.com/tendermint/planet/x/mars/types", module.Types.Path())
+	require.Len(t, module.Dependencies, 1)
+
+	dependency := module.Dependencies[0]
+	require.Equal(t, "BankKeeper", dependency.Interface)
+	require.Equal(t, "github.com/tendermint/planet/x/bank.Keeper", dependency.Keeper.String())
+
+	var methods []string
+	for _, method := range dependency.Methods {
+		var signature bytes.Buffer
+		types.WriteSignature(&signature, method.Signature, nil)
+		methods = append(methods, method.Name+signature.String())
+	}
+	require.Equal(t, []string{
+		"Burn(amounts ...uint64)",
+		"GetBalance(addr string) uint64",
+		"SendCoins(from string, to string, amount uint64) error",
+	}, methods)
+}
+
+func TestDiscoverInvalidModule(t *testing.T) {
+	_, err := Discover(context.Background(), "testdata/planet", "github.com/tendermint/planet", "venus")
+	require.Error(t, err)
+}
+
+func TestDiscoverTypeError(t *testing.T) {
+	_, err := Discover(context.Background(), "testdata/planet", "github.com/tendermint/planet", "jupiter")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "k.fee undefined")
+}
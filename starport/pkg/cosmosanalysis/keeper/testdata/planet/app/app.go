@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/tendermint/planet/x/bank"
+	"github.com/tendermint/planet/x/mars"
+	marskeeper "github.com/tendermint/planet/x/mars/keeper"
+)
+
+type App struct {
+	BankKeeper bank.Keeper
+	MarsKeeper marskeeper.Keeper
+}
+
+func New() *App {
+	app := &App{}
+	app.MarsKeeper = *marskeeper.NewKeeper(app.BankKeeper)
+	_ = mars.NewAppModule(app.MarsKeeper, app.BankKeeper)
+	return app
+}
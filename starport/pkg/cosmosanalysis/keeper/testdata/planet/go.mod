@@ -0,0 +1,3 @@
+module github.com/tendermint/planet
+
+go 1.16
@@ -0,0 +1,15 @@
+package keeper
+
+import "github.com/tendermint/planet/x/jupiter/types"
+
+type Keeper struct {
+	bankKeeper types.BankKeeper
+}
+
+func NewKeeper(bankKeeper types.BankKeeper) *Keeper {
+	return &Keeper{bankKeeper: bankKeeper}
+}
+
+func (k Keeper) Balance(addr string) uint64 {
+	return k.bankKeeper.GetBalance(addr) + k.fee
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import "github.com/tendermint/planet/x/mars/types"
+
+type Keeper struct {
+	bankKeeper types.BankKeeper
+}
+
+func NewKeeper(bankKeeper types.BankKeeper) *Keeper {
+	return &Keeper{bankKeeper: bankKeeper}
+}
+
+func (k Keeper) Transfer(from, to string, amount uint64) error {
+	if err := k.bankKeeper.SendCoins(from, to, amount); err != nil {
+		return err
+	}
+	k.bankKeeper.Burn(amount)
+	return nil
+}
@@ -0,0 +1,19 @@
+package mars
+
+import (
+	"github.com/tendermint/planet/x/mars/keeper"
+	"github.com/tendermint/planet/x/mars/types"
+)
+
+type AppModule struct {
+	keeper     keeper.Keeper
+	bankKeeper types.BankKeeper
+}
+
+func NewAppModule(keeper keeper.Keeper, bankKeeper types.BankKeeper) AppModule {
+	return AppModule{keeper: keeper, bankKeeper: bankKeeper}
+}
+
+func (am AppModule) Balance(addr string) uint64 {
+	return am.bankKeeper.GetBalance(addr)
+}
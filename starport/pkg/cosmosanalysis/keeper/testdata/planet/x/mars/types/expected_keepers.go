@@ -0,0 +1,5 @@
+package types
+
+type BankKeeper interface {
+	Burn(amounts ...uint64)
+}
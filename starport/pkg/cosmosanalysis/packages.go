@@ -0,0 +1,75 @@
+package cosmosanalysis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/gocmd"
+)
+
+// Package is a Go package listed by go list.
+type Package struct {
+	Dir        string
+	ImportPath string
+	GoFiles    []string
+	Export     string
+}
+
+// ListPackages lists with their dependencies the packages matching the patterns in the Go module at path, the
+// dependencies are listed before the packages depending on them and the packages which can't be built are listed
+// without export data.
+func ListPackages(ctx context.Context, path string, patterns ...string) ([]Package, error) {
+	var (
+		out    = &bytes.Buffer{}
+		errOut = &bytes.Buffer{}
+	)
+	if err := cmdrunner.
+		New().
+		Run(ctx, step.New(
+			step.Exec(gocmd.Name(), append([]string{"list", "-e", "-export", "-deps", "-json"}, patterns...)...),
+			step.Workdir(path),
+			step.Stdout(out),
+			step.Stderr(errOut),
+		)); err != nil {
+		return nil, fmt.Errorf("%s: %s", err.Error(), errOut.String())
+	}
+
+	var pkgs []Package
+	d := json.NewDecoder(out)
+	for {
+		var pkg Package
+		if err := d.Decode(&pkg); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
+
+// NewExportDataImporter returns an importer of the packages from the export data listed with them.
+func NewExportDataImporter(fileSet *token.FileSet, pkgs []Package) types.Importer {
+	exports := make(map[string]string)
+	for _, pkg := range pkgs {
+		exports[pkg.ImportPath] = pkg.Export
+	}
+
+	return importer.ForCompiler(fileSet, "gc", func(importPath string) (io.ReadCloser, error) {
+		export, ok := exports[importPath]
+		if !ok || export == "" {
+			return nil, fmt.Errorf("no export data for %s", importPath)
+		}
+		return os.Open(export)
+	})
+}
@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+)
+
+type App struct {
+	cdc           *codec.LegacyAmino
+	appCodec      codec.Codec
+	BankKeeper    bankkeeper.Keeper
+	ReserveKeeper bankkeeper.Keeper
+}
+
+func (app *App) RegisterAPIRoutes() {}
+
+func (app *App) RegisterTxService() {}
+
+func (app *App) RegisterTendermintService() {}
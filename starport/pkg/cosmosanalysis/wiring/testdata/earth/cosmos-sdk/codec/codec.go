@@ -0,0 +1,12 @@
+package codec
+
+type BinaryCodec interface {
+	Marshal(o interface{}) ([]byte, error)
+}
+
+type Codec interface {
+	BinaryCodec
+	MarshalJSON(o interface{}) ([]byte, error)
+}
+
+type LegacyAmino struct{}
@@ -0,0 +1,21 @@
+package types
+
+type StoreKey interface {
+	Name() string
+}
+
+type KVStoreKey struct {
+	name string
+}
+
+func (key *KVStoreKey) Name() string {
+	return key.name
+}
+
+type MemoryStoreKey struct {
+	name string
+}
+
+func (key *MemoryStoreKey) Name() string {
+	return key.name
+}
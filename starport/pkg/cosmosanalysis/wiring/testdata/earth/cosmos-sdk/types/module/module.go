@@ -0,0 +1,10 @@
+package module
+
+type AppModuleBasic interface {
+	Name() string
+}
+
+type AppModule interface {
+	AppModuleBasic
+	Route() string
+}
@@ -0,0 +1,9 @@
+package types
+
+import "github.com/cosmos/cosmos-sdk/store/types"
+
+type (
+	StoreKey       = types.StoreKey
+	KVStoreKey     = types.KVStoreKey
+	MemoryStoreKey = types.MemoryStoreKey
+)
@@ -0,0 +1,5 @@
+package types
+
+type Subspace struct {
+	name string
+}
@@ -0,0 +1,7 @@
+module github.com/tendermint/earth
+
+go 1.16
+
+require github.com/cosmos/cosmos-sdk v0.44.0
+
+replace github.com/cosmos/cosmos-sdk => ./cosmos-sdk
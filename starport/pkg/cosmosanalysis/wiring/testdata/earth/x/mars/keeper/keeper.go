@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/earth/x/mars/types"
+)
+
+type Keeper struct{}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey,
+	memKey sdk.StoreKey,
+	ps paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	options ...string,
+) *Keeper {
+	return &Keeper{}
+}
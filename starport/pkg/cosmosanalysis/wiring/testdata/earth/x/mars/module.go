@@ -0,0 +1,25 @@
+package mars
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tendermint/earth/x/mars/keeper"
+	"github.com/tendermint/earth/x/mars/types"
+)
+
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+type AppModule struct {
+	AppModuleBasic
+}
+
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, bankKeeper types.BankKeeper) AppModule {
+	return AppModule{}
+}
+
+func (AppModule) Route() string {
+	return types.ModuleName
+}
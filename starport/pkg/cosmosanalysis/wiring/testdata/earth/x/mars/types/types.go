@@ -0,0 +1,11 @@
+package types
+
+const (
+	ModuleName  = "mars"
+	StoreKey    = ModuleName
+	MemStoreKey = "mem_mars"
+)
+
+type BankKeeper interface {
+	SendCoins(from, to string, amount uint64) error
+}
@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/earth/x/venus/types"
+)
+
+type Keeper struct{}
+
+func NewKeeper(storeKey sdk.StoreKey, coinKeeper types.CoinKeeper, authority string) Keeper {
+	return Keeper{}
+}
@@ -0,0 +1,24 @@
+package venus
+
+import (
+	"github.com/tendermint/earth/x/venus/keeper"
+	"github.com/tendermint/earth/x/venus/types"
+)
+
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+type AppModule struct {
+	AppModuleBasic
+}
+
+func NewAppModule(keeper *keeper.Keeper) AppModule {
+	return AppModule{}
+}
+
+func (AppModule) Route() string {
+	return types.ModuleName
+}
@@ -0,0 +1,7 @@
+package types
+
+const ModuleName = "venus"
+
+type CoinKeeper interface {
+	GetBalance(addr string) uint64
+}
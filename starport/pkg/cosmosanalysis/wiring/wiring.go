@@ -0,0 +1,549 @@
+// Package wiring provides a toolset for statically analysing how a Cosmos SDK module imported from a Go module can be
+// wired into an app
+package wiring
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+const (
+	sdkModulePkg       = "github.com/cosmos/cosmos-sdk/types/module"
+	sdkStoreTypesPkg   = "github.com/cosmos/cosmos-sdk/store/types"
+	sdkCodecPkg        = "github.com/cosmos/cosmos-sdk/codec"
+	sdkParamsTypesPkg  = "github.com/cosmos/cosmos-sdk/x/params/types"
+	typesPkg           = "types"
+	keeperPkg          = "keeper"
+	modulePkg          = "module"
+	newKeeperFunc      = "NewKeeper"
+	newAppModuleFunc   = "NewAppModule"
+	moduleNameConst    = "ModuleName"
+	storeKeyConst      = "StoreKey"
+	memStoreKeyConst   = "MemStoreKey"
+	appModuleBasicType = "AppModuleBasic"
+	appModuleType      = "AppModule"
+)
+
+// appImplementation are the methods implemented by the app
+var appImplementation = []string{
+	"RegisterAPIRoutes",
+	"RegisterTxService",
+	"RegisterTendermintService",
+}
+
+// ArgumentKind is the kind of the value given by the app to a parameter of a constructor of the module.
+type ArgumentKind int
+
+const (
+	// ArgumentUnresolved is a parameter the app can't give a value to automatically.
+	ArgumentUnresolved ArgumentKind = iota
+
+	// ArgumentApp is a value of the app given as is, e.g. app.BankKeeper.
+	ArgumentApp
+
+	// ArgumentKeeper is the keeper of the module.
+	ArgumentKeeper
+
+	// ArgumentKeeperReference is a reference to the keeper of the module.
+	ArgumentKeeperReference
+
+	// ArgumentStoreKey is the KV store key of the module.
+	ArgumentStoreKey
+
+	// ArgumentMemStoreKey is the memory store key of the module.
+	ArgumentMemStoreKey
+
+	// ArgumentSubspace is the params subspace of the module.
+	ArgumentSubspace
+)
+
+// Argument is the value given by the app to a parameter of a constructor of the module.
+type Argument struct {
+	// Name of the parameter.
+	Name string
+
+	// Type of the parameter.
+	Type types.Type
+
+	// Kind of the value given by the app.
+	Kind ArgumentKind
+
+	// Expression is the expression of the app giving the value of an ArgumentApp, e.g. app.BankKeeper.
+	Expression string
+
+	// Candidates are the expressions of the app which could give the value of an ArgumentUnresolved.
+	Candidates []string
+}
+
+// Constructor is a constructor of the module called by the app.
+type Constructor struct {
+	// Name of the function.
+	Name string
+
+	// ReturnsPointer is true when the constructor returns a pointer.
+	ReturnsPointer bool
+
+	// Arguments given by the app in the order of the parameters of the constructor, a variadic parameter is left
+	// empty.
+	Arguments []Argument
+}
+
+// Module describes how a module imported from a Go module is wired into the app.
+type Module struct {
+	// PkgPath is the import path of the package of the module.
+	PkgPath string
+
+	// KeeperPkgPath is the import path of the keeper package of the module, empty when the module has no keeper.
+	KeeperPkgPath string
+
+	// TypesPkgPath is the import path of the types package of the module declaring its name, it can be the package
+	// of the module itself.
+	TypesPkgPath string
+
+	// StoreKeyPkgPath is the import path of the package declaring the StoreKey constant of the module, empty when
+	// the module has no KV store.
+	StoreKeyPkgPath string
+
+	// MemStoreKeyPkgPath is the import path of the package declaring the MemStoreKey constant of the module, empty
+	// when the module has no memory store.
+	MemStoreKeyPkgPath string
+
+	// Name of the module declared by the ModuleName constant of its types package.
+	Name string
+
+	// AppModuleBasic is the name of the type of the module implementing the AppModuleBasic of the SDK.
+	AppModuleBasic string
+
+	// Keeper is the constructor of the keeper of the module, nil when the module has no keeper.
+	Keeper *Constructor
+
+	// AppModule is the constructor of the app module of the module.
+	AppModule Constructor
+}
+
+// Resolved checks if the app can give a value to all the parameters of the constructors of the module.
+func (m Module) Resolved() bool {
+	for _, c := range m.constructors() {
+		for _, arg := range c.Arguments {
+			if arg.Kind == ArgumentUnresolved {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Uses checks if a constructor of the module is given a value of a kind.
+func (m Module) Uses(kind ArgumentKind) bool {
+	for _, c := range m.constructors() {
+		for _, arg := range c.Arguments {
+			if arg.Kind == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m Module) constructors() []Constructor {
+	if m.Keeper == nil {
+		return []Constructor{m.AppModule}
+	}
+	return []Constructor{*m.Keeper, m.AppModule}
+}
+
+// Discover type checks the package of a module imported by the Go module of the app and the app package to find how
+// the module can be wired into the app: its AppModuleBasic, the constructors of its keeper and app module and the
+// values of the app to give to them.
+func Discover(ctx context.Context, appPath, appPkgPath, modulePkgPath string) (Module, error) {
+	module := Module{PkgPath: modulePkgPath}
+
+	// The types and keeper packages are subpackages of the package of the module or, when the package of the module
+	// is a module subpackage as in the Cosmos SDK, subpackages of its parent
+	dirs := []string{modulePkgPath}
+	if path.Base(modulePkgPath) == modulePkg {
+		dirs = append(dirs, path.Dir(modulePkgPath))
+	}
+	var typesCandidates, keeperCandidates []string
+	for _, dir := range dirs {
+		typesCandidates = append(typesCandidates, path.Join(dir, typesPkg), dir)
+		keeperCandidates = append(keeperCandidates, path.Join(dir, keeperPkg))
+	}
+
+	patterns := append([]string{appPkgPath, sdkModulePkg, sdkStoreTypesPkg}, typesCandidates...)
+	pkgs, err := cosmosanalysis.ListPackages(ctx, appPath, append(patterns, keeperCandidates...)...)
+	if err != nil {
+		return module, err
+	}
+	imp := cosmosanalysis.NewExportDataImporter(token.NewFileSet(), pkgs)
+
+	appPkg, err := imp.Import(appPkgPath)
+	if err != nil {
+		return module, fmt.Errorf("the app package %s can't be loaded: %w", appPkgPath, err)
+	}
+	imported, err := isImported(pkgs, appPkgPath, modulePkgPath)
+	if err != nil {
+		return module, err
+	}
+	if imported {
+		return module, fmt.Errorf("the module %s is already imported by the app", modulePkgPath)
+	}
+	app, err := findApp(appPkg)
+	if err != nil {
+		return module, err
+	}
+
+	modulePackage, err := imp.Import(modulePkgPath)
+	if err != nil {
+		return module, fmt.Errorf("the package %s can't be loaded: %w", modulePkgPath, err)
+	}
+	sdkModule, err := imp.Import(sdkModulePkg)
+	if err != nil {
+		return module, fmt.Errorf("the Cosmos SDK can't be loaded: %w", err)
+	}
+	sdkStoreTypes, err := imp.Import(sdkStoreTypesPkg)
+	if err != nil {
+		return module, fmt.Errorf("the Cosmos SDK can't be loaded: %w", err)
+	}
+
+	// The types package is the first one declaring the name of the module
+	var typesPackage *types.Package
+	for _, candidate := range typesCandidates {
+		pkg, err := imp.Import(candidate)
+		if err != nil {
+			continue
+		}
+		if name, ok := stringConstant(pkg, moduleNameConst); ok {
+			typesPackage, module.TypesPkgPath, module.Name = pkg, candidate, name
+			break
+		}
+	}
+	if typesPackage == nil {
+		return module, fmt.Errorf("the types package of the module %s declaring %s can't be found", modulePkgPath, moduleNameConst)
+	}
+
+	// The AppModuleBasic is the type of the module implementing the one of the SDK, preferably named as it
+	if module.AppModuleBasic = findImplementation(modulePackage, sdkModule, appModuleBasicType); module.AppModuleBasic == "" {
+		return module, fmt.Errorf("%s doesn't implement the %s of the Cosmos SDK", modulePkgPath, appModuleBasicType)
+	}
+
+	// The keeper is optional, a module can work without state
+	var (
+		keeperPackage *types.Package
+		newKeeper     *types.Func
+	)
+	for _, candidate := range keeperCandidates {
+		pkg, err := imp.Import(candidate)
+		if err != nil {
+			continue
+		}
+		if f, ok := pkg.Scope().Lookup(newKeeperFunc).(*types.Func); ok {
+			keeperPackage, newKeeper, module.KeeperPkgPath = pkg, f, candidate
+			break
+		}
+	}
+
+	// The store keys are declared by the types package or by the keeper package
+	r := resolver{
+		app: app,
+		storeKeys: map[string]types.Type{
+			"StoreKey":       sdkStoreTypes.Scope().Lookup("StoreKey").Type(),
+			"KVStoreKey":     types.NewPointer(sdkStoreTypes.Scope().Lookup("KVStoreKey").Type()),
+			"MemoryStoreKey": types.NewPointer(sdkStoreTypes.Scope().Lookup("MemoryStoreKey").Type()),
+		},
+	}
+	for _, pkg := range []*types.Package{typesPackage, keeperPackage} {
+		if pkg == nil {
+			continue
+		}
+		if _, ok := stringConstant(pkg, storeKeyConst); ok && module.StoreKeyPkgPath == "" {
+			module.StoreKeyPkgPath = pkg.Path()
+		}
+		if _, ok := stringConstant(pkg, memStoreKeyConst); ok && module.MemStoreKeyPkgPath == "" {
+			module.MemStoreKeyPkgPath = pkg.Path()
+		}
+	}
+	r.hasStoreKey = module.StoreKeyPkgPath != ""
+	r.hasMemStoreKey = module.MemStoreKeyPkgPath != ""
+
+	if newKeeper != nil {
+		signature := newKeeper.Type().(*types.Signature)
+		if signature.Results().Len() == 0 {
+			return module, fmt.Errorf("%s.%s doesn't return a keeper", module.KeeperPkgPath, newKeeperFunc)
+		}
+		module.Keeper = &Constructor{Name: newKeeperFunc}
+		r.keeper = signature.Results().At(0).Type()
+		if pointer, ok := r.keeper.(*types.Pointer); ok {
+			module.Keeper.ReturnsPointer = true
+			r.keeper = pointer.Elem()
+		}
+		module.Keeper.Arguments = r.resolveAll(signature)
+	}
+
+	newAppModule, ok := modulePackage.Scope().Lookup(newAppModuleFunc).(*types.Func)
+	if !ok {
+		return module, fmt.Errorf("%s doesn't declare %s", modulePkgPath, newAppModuleFunc)
+	}
+	signature := newAppModule.Type().(*types.Signature)
+	appModule := sdkModule.Scope().Lookup(appModuleType).Type().Underlying().(*types.Interface)
+	if signature.Results().Len() == 0 || !types.Implements(signature.Results().At(0).Type(), appModule) {
+		return module, fmt.Errorf("%s.%s doesn't return an implementation of the %s of the Cosmos SDK",
+			modulePkgPath, newAppModuleFunc, appModuleType)
+	}
+	module.AppModule = Constructor{
+		Name:      newAppModuleFunc,
+		Arguments: r.resolveAll(signature),
+	}
+
+	return module, nil
+}
+
+// resolver resolves the values of the app given to the parameters of the constructors of the module.
+type resolver struct {
+	app            *types.Named
+	keeper         types.Type
+	storeKeys      map[string]types.Type
+	hasStoreKey    bool
+	hasMemStoreKey bool
+}
+
+// resolveAll resolves the values of the app given to the parameters of a constructor.
+func (r resolver) resolveAll(signature *types.Signature) []Argument {
+	var args []Argument
+	params := signature.Params()
+	for i := 0; i < params.Len(); i++ {
+		if signature.Variadic() && i == params.Len()-1 {
+			break
+		}
+		args = append(args, r.resolve(params.At(i)))
+	}
+	return args
+}
+
+// resolve resolves the value of the app given to a parameter of a constructor.
+func (r resolver) resolve(param *types.Var) Argument {
+	arg := Argument{
+		Name: param.Name(),
+		Type: param.Type(),
+	}
+
+	name, pkgPath, isPointer := typeName(param.Type())
+	storeKey := r.storeKey(param.Type())
+	switch {
+	case r.keeper != nil && types.Identical(param.Type(), r.keeper):
+		arg.Kind = ArgumentKeeper
+	case r.keeper != nil && types.Identical(param.Type(), types.NewPointer(r.keeper)):
+		arg.Kind = ArgumentKeeperReference
+	case storeKey != "":
+		// A store key parameter is a memory store key when its type or name says so
+		isMemory := storeKey == "MemoryStoreKey" ||
+			(storeKey == "StoreKey" && strings.Contains(strings.ToLower(param.Name()), "mem"))
+		switch {
+		case isMemory && r.hasMemStoreKey:
+			arg.Kind = ArgumentMemStoreKey
+		case !isMemory && r.hasStoreKey:
+			arg.Kind = ArgumentStoreKey
+		}
+	case pkgPath == sdkParamsTypesPkg && name == "Subspace" && !isPointer:
+		arg.Kind = ArgumentSubspace
+	case pkgPath == sdkCodecPkg && name == "LegacyAmino" && isPointer:
+		arg.Kind, arg.Expression = ArgumentApp, "cdc"
+	case pkgPath == sdkCodecPkg && types.IsInterface(param.Type()):
+		arg.Kind, arg.Expression = ArgumentApp, "appCodec"
+	case name != "":
+		r.resolveFromApp(&arg)
+	}
+
+	return arg
+}
+
+// storeKey returns the name of the store key type of the Cosmos SDK typing a value, the type can be aliased as in the
+// types package of the Cosmos SDK.
+func (r resolver) storeKey(t types.Type) string {
+	for name, storeKey := range r.storeKeys {
+		if types.Identical(t, storeKey) {
+			return name
+		}
+	}
+	return ""
+}
+
+// resolveFromApp resolves the value given to a parameter from the fields of the app, the fields of its fields and
+// the getters of the app, in this order. Several values are disambiguated by the name of the parameter or of its type.
+func (r resolver) resolveFromApp(arg *Argument) {
+	if iface, ok := arg.Type.Underlying().(*types.Interface); ok && iface.Empty() {
+		return
+	}
+
+	var (
+		fields       []string
+		nestedFields []string
+		getters      []string
+		appStruct, _ = r.app.Underlying().(*types.Struct)
+		candidate    = func(expression string, t types.Type) []string {
+			switch {
+			case types.AssignableTo(t, arg.Type):
+				return []string{expression}
+			case types.AssignableTo(types.NewPointer(t), arg.Type):
+				return []string{"&" + expression}
+			}
+			return nil
+		}
+	)
+	for i := 0; appStruct != nil && i < appStruct.NumFields(); i++ {
+		field := appStruct.Field(i)
+		expression := "app." + field.Name()
+		fields = append(fields, candidate(expression, field.Type())...)
+
+		if !field.Exported() {
+			continue
+		}
+		t := field.Type()
+		if pointer, ok := t.(*types.Pointer); ok {
+			t = pointer.Elem()
+		}
+		if fieldStruct, ok := t.Underlying().(*types.Struct); ok {
+			for j := 0; j < fieldStruct.NumFields(); j++ {
+				if nested := fieldStruct.Field(j); nested.Exported() {
+					nestedFields = append(nestedFields, candidate(expression+"."+nested.Name(), nested.Type())...)
+				}
+			}
+		}
+	}
+
+	methods := types.NewMethodSet(types.NewPointer(r.app))
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.At(i).Obj()
+		signature := method.Type().(*types.Signature)
+		if method.Exported() && signature.Params().Len() == 0 && signature.Results().Len() == 1 &&
+			types.AssignableTo(signature.Results().At(0).Type(), arg.Type) {
+			getters = append(getters, fmt.Sprintf("app.%s()", method.Name()))
+		}
+	}
+
+	typeName, _, _ := typeName(arg.Type)
+	for _, candidates := range [][]string{fields, nestedFields, getters} {
+		if len(candidates) == 0 {
+			continue
+		}
+		if len(candidates) > 1 {
+			var named []string
+			for _, c := range candidates {
+				last := strings.TrimSuffix(c[strings.LastIndex(c, ".")+1:], "()")
+				if last == typeName || last == strings.Title(arg.Name) {
+					named = append(named, c)
+				}
+			}
+			if len(named) != 1 {
+				sort.Strings(candidates)
+				arg.Candidates = candidates
+				return
+			}
+			candidates = named
+		}
+		arg.Kind, arg.Expression = ArgumentApp, candidates[0]
+		return
+	}
+}
+
+// isImported checks if a package is imported by the source files of another listed package.
+func isImported(pkgs []cosmosanalysis.Package, importerPath, importedPath string) (bool, error) {
+	for _, pkg := range pkgs {
+		if pkg.ImportPath != importerPath {
+			continue
+		}
+		for _, name := range pkg.GoFiles {
+			f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(pkg.Dir, name), nil, parser.ImportsOnly)
+			if err != nil {
+				return false, err
+			}
+			for _, spec := range f.Imports {
+				if strings.Trim(spec.Path.Value, `"`) == importedPath {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// findApp finds the type of the app in the app package.
+func findApp(appPkg *types.Package) (*types.Named, error) {
+	var found []*types.Named
+	for _, name := range appPkg.Scope().Names() {
+		obj, ok := appPkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		methods := types.NewMethodSet(types.NewPointer(named))
+		implements := true
+		for _, method := range appImplementation {
+			if methods.Lookup(nil, method) == nil {
+				implements = false
+			}
+		}
+		if implements {
+			found = append(found, named)
+		}
+	}
+	if len(found) != 1 {
+		return nil, fmt.Errorf("the app package %s should contain a single app", appPkg.Path())
+	}
+	return found[0], nil
+}
+
+// findImplementation finds the name of the type of a package implementing an interface of another package, the type
+// named as the interface is preferred.
+func findImplementation(pkg, interfacePkg *types.Package, interfaceName string) string {
+	iface := interfacePkg.Scope().Lookup(interfaceName).Type().Underlying().(*types.Interface)
+
+	var found string
+	for _, name := range pkg.Scope().Names() {
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok || !obj.Exported() || types.IsInterface(obj.Type()) || !types.Implements(obj.Type(), iface) {
+			continue
+		}
+		if name == interfaceName {
+			return name
+		}
+		if found == "" {
+			found = name
+		}
+	}
+	return found
+}
+
+// stringConstant returns the value of a string constant of a package.
+func stringConstant(pkg *types.Package, name string) (string, bool) {
+	c, ok := pkg.Scope().Lookup(name).(*types.Const)
+	if !ok || c.Val().Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(c.Val()), true
+}
+
+// typeName returns the name and the package path of a named type or of the type pointed by a pointer.
+func typeName(t types.Type) (name, pkgPath string, isPointer bool) {
+	if pointer, ok := t.(*types.Pointer); ok {
+		t, isPointer = pointer.Elem(), true
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return "", "", isPointer
+	}
+	return named.Obj().Name(), named.Obj().Pkg().Path(), isPointer
+}
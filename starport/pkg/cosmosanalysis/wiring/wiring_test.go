@@ -0,0 +1,80 @@
+package wiring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAppPath    = "testdata/earth"
+	testAppPkgPath = "github.com/tendermint/earth/app"
+)
+
+// argument is an argument without its type for comparisons.
+type argument struct {
+	Name       string
+	Kind       ArgumentKind
+	Expression string
+	Candidates []string
+}
+
+func arguments(c Constructor) (args []argument) {
+	for _, arg := range c.Arguments {
+		args = append(args, argument{arg.Name, arg.Kind, arg.Expression, arg.Candidates})
+	}
+	return args
+}
+
+func TestDiscover(t *testing.T) {
+	module, err := Discover(context.Background(), testAppPath, testAppPkgPath, "github.com/tendermint/earth/x/mars")
+	require.NoError(t, err)
+	require.Equal(t, "mars", module.Name)
+	require.Equal(t, "AppModuleBasic", module.AppModuleBasic)
+	require.Equal(t, "github.com/tendermint/earth/x/mars/keeper", module.KeeperPkgPath)
+	require.Equal(t, "github.com/tendermint/earth/x/mars/types", module.TypesPkgPath)
+	require.Equal(t, "github.com/tendermint/earth/x/mars/types", module.StoreKeyPkgPath)
+	require.Equal(t, "github.com/tendermint/earth/x/mars/types", module.MemStoreKeyPkgPath)
+	require.True(t, module.Resolved())
+	require.True(t, module.Uses(ArgumentSubspace))
+
+	require.NotNil(t, module.Keeper)
+	require.True(t, module.Keeper.ReturnsPointer)
+	require.Equal(t, []argument{
+		{Name: "cdc", Kind: ArgumentApp, Expression: "appCodec"},
+		{Name: "storeKey", Kind: ArgumentStoreKey},
+		{Name: "memKey", Kind: ArgumentMemStoreKey},
+		{Name: "ps", Kind: ArgumentSubspace},
+		{Name: "bankKeeper", Kind: ArgumentApp, Expression: "app.BankKeeper"},
+	}, arguments(*module.Keeper))
+
+	require.Equal(t, []argument{
+		{Name: "cdc", Kind: ArgumentApp, Expression: "appCodec"},
+		{Name: "keeper", Kind: ArgumentKeeper},
+		{Name: "bankKeeper", Kind: ArgumentApp, Expression: "app.BankKeeper"},
+	}, arguments(module.AppModule))
+}
+
+func TestDiscoverUnresolved(t *testing.T) {
+	module, err := Discover(context.Background(), testAppPath, testAppPkgPath, "github.com/tendermint/earth/x/venus")
+	require.NoError(t, err)
+	require.False(t, module.Resolved())
+
+	require.NotNil(t, module.Keeper)
+	require.False(t, module.Keeper.ReturnsPointer)
+	require.Equal(t, []argument{
+		{Name: "storeKey", Kind: ArgumentUnresolved},
+		{Name: "coinKeeper", Kind: ArgumentUnresolved, Candidates: []string{"app.BankKeeper", "app.ReserveKeeper"}},
+		{Name: "authority", Kind: ArgumentUnresolved},
+	}, arguments(*module.Keeper))
+
+	require.Equal(t, []argument{
+		{Name: "keeper", Kind: ArgumentKeeperReference},
+	}, arguments(module.AppModule))
+}
+
+func TestDiscoverInvalidModule(t *testing.T) {
+	_, err := Discover(context.Background(), testAppPath, testAppPkgPath, "github.com/tendermint/earth/x/jupiter")
+	require.Error(t, err)
+}
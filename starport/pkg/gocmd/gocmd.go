@@ -28,6 +28,9 @@ const (
 
 	// CommandModVerify represents go mod "verify" command.
 	CommandModVerify = "verify"
+
+	// CommandGet represents go "get" command.
+	CommandGet = "get"
 )
 
 const (
@@ -61,6 +64,16 @@ func ModVerify(ctx context.Context, path string, options ...exec.Option) error {
 	return exec.Exec(ctx, []string{Name(), CommandMod, CommandModVerify}, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// Get runs go get on path with options to add the packages to the requirements of the Go module.
+func Get(ctx context.Context, path string, pkgs []string, options ...exec.Option) error {
+	command := []string{
+		Name(),
+		CommandGet,
+	}
+	command = append(command, pkgs...)
+	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
+}
+
 // BuildPath runs go install on cmd folder with options.
 func BuildPath(ctx context.Context, output, binary, path string, flags []string, options ...exec.Option) error {
 	binaryOutput, err := binaryPath(output, binary)
@@ -89,6 +89,30 @@ func (b builder) buildMessages() (messages []Message) {
 	return messages
 }
 
+// buildMessageFields returns the fields of a message in their order of declaration.
+func buildMessageFields(message *proto.Message) (fields []MessageField) {
+	for _, elem := range message.Elements {
+		field, ok := elem.(*proto.NormalField)
+		if !ok {
+			continue
+		}
+
+		options := make(map[string]string)
+		for _, option := range field.Options {
+			options[option.Name] = option.Constant.Source
+		}
+
+		fields = append(fields, MessageField{
+			Name:     field.Name,
+			Type:     field.Type,
+			Repeated: field.Repeated,
+			Options:  options,
+		})
+	}
+
+	return fields
+}
+
 func (b builder) toServices(ps []*proto.Service) (services []Service) {
 	for _, service := range ps {
 		s := Service{
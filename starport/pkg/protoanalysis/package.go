@@ -83,6 +83,21 @@ type Message struct {
 	HighestFieldNumber int
 }
 
+// MessageField is a field of a proto message.
+type MessageField struct {
+	// Name of the field.
+	Name string
+
+	// Type of the field, prefixed with the package name when the type is defined in another package.
+	Type string
+
+	// Repeated is true if the field is a list of values of its type.
+	Repeated bool
+
+	// Options of the field, the option names are associated with their constant value.
+	Options map[string]string
+}
+
 // Service is an RPC service.
 type Service struct {
 	// Name of the services.
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/emicklei/proto"
 	"github.com/pkg/errors"
 )
 
@@ -84,6 +85,48 @@ func HasMessages(ctx context.Context, path string, names ...string) error {
 	return nil
 }
 
+// MessageFields returns the fields of the message with given name defined in the proto package under path.
+func MessageFields(ctx context.Context, path, name string) ([]MessageField, error) {
+	pkgs, err := parse(ctx, path, protoFilePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, message := range pkg.messages() {
+			if message.Name == name {
+				return buildMessageFields(message), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid proto message name %s", name)
+}
+
+// EnumValues returns the names of the values of the enum with given name defined in the proto package under path
+// in their order of declaration.
+func EnumValues(ctx context.Context, path, name string) ([]string, error) {
+	pkgs, err := parse(ctx, path, protoFilePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, enum := range pkg.enums() {
+			if enum.Name != name {
+				continue
+			}
+			var values []string
+			for _, elem := range enum.Elements {
+				if value, ok := elem.(*proto.EnumField); ok {
+					values = append(values, value.Name)
+				}
+			}
+			return values, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid proto enum name %s", name)
+}
+
 // IsImported checks if the proto package under path imports list of dependencies.
 func IsImported(path string, dependencies ...string) error {
 	f, err := ParseFile(path)
@@ -17,6 +17,32 @@ func TestNestedMessages(t *testing.T) {
 	require.Equal(t, "A_B_C", pkg.Messages[2].Name)
 }
 
+func TestMessageFields(t *testing.T) {
+	fields, err := MessageFields(context.Background(), "testdata/liquidity", "PoolMetadata")
+	require.NoError(t, err)
+
+	require.Len(t, fields, 3)
+	require.Equal(t, "pool_id", fields[0].Name)
+	require.Equal(t, "uint64", fields[0].Type)
+	require.False(t, fields[0].Repeated)
+	require.Equal(t, "cosmos.base.v1beta1.Coin", fields[1].Type)
+	require.Equal(t, "false", fields[1].Options["(gogoproto.nullable)"])
+	require.Equal(t, "reserve_coins", fields[2].Name)
+	require.True(t, fields[2].Repeated)
+
+	_, err = MessageFields(context.Background(), "testdata/liquidity", "Unknown")
+	require.Error(t, err)
+}
+
+func TestEnumValues(t *testing.T) {
+	values, err := EnumValues(context.Background(), "testdata/enums", "Status")
+	require.NoError(t, err)
+	require.Equal(t, []string{"STATUS_DRAFT", "STATUS_PUBLISHED", "STATUS_ARCHIVED"}, values)
+
+	_, err = EnumValues(context.Background(), "testdata/enums", "Post")
+	require.Error(t, err)
+}
+
 func TestLiquidity(t *testing.T) {
 	packages, err := Parse(context.Background(), nil, "testdata/liquidity")
 	require.NoError(t, err)
@@ -36,6 +36,33 @@ func RunWithValidation(
 	clip *clipper.Clipper,
 	gens ...*genny.Generator,
 ) (sm SourceModification, err error) {
+	return NewRunner(clip).Run(gens...)
+}
+
+// Runner checks and runs the generators of several runs, the source modification of all the runs is accumulated.
+// each generator is written to the disk once checked so the generators of the next runs can be created from the
+// files of the previous ones.
+type Runner struct {
+	clip *clipper.Clipper
+	sm   SourceModification
+}
+
+// NewRunner returns a runner of generators modifying the files with the clipper.
+func NewRunner(clip *clipper.Clipper) *Runner {
+	return &Runner{
+		clip: clip,
+		sm:   NewSourceModification(),
+	}
+}
+
+// SourceModification returns the source modification of all the runs.
+func (r *Runner) SourceModification() SourceModification {
+	return r.sm
+}
+
+// Run checks the generators with a dry run and then execute the wet runner to the generators, the source
+// modification of the run is returned.
+func (r *Runner) Run(gens ...*genny.Generator) (sm SourceModification, err error) {
 	// run executes the provided runner with the provided generator
 	run := func(runner *genny.Runner, gen *genny.Generator) error {
 		err := runner.With(gen)
@@ -44,6 +71,8 @@ func RunWithValidation(
 		}
 		return runner.Run()
 	}
+
+	sm = NewSourceModification()
 	for _, gen := range gens {
 		// check with a dry runner the generators
 		dryRunner := DryRunner(context.Background())
@@ -53,12 +82,11 @@ func RunWithValidation(
 			}
 			return sm, err
 		}
-		if err := clip.Err(); err != nil {
+		if err := r.clip.Err(); err != nil {
 			return sm, err
 		}
 
 		// fetch the source modification
-		sm = NewSourceModification()
 		for _, file := range dryRunner.Results().Files {
 			fileName := file.Name()
 			_, err := os.Stat(fileName)
@@ -79,6 +107,7 @@ func RunWithValidation(
 		if err := run(genny.WetRunner(context.Background()), gen); err != nil {
 			return sm, err
 		}
+		r.sm.Merge(sm)
 	}
 	return sm, nil
 }
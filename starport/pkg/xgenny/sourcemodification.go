@@ -1,15 +1,17 @@
 package xgenny
 
-// SourceModification describes modified and created files in the source code after a run
+// SourceModification describes modified, created and removed files in the source code after a run
 type SourceModification struct {
 	modified map[string]struct{}
 	created  map[string]struct{}
+	removed  map[string]struct{}
 }
 
 func NewSourceModification() SourceModification {
 	return SourceModification{
 		make(map[string]struct{}),
 		make(map[string]struct{}),
+		make(map[string]struct{}),
 	}
 }
 
@@ -29,12 +31,21 @@ func (sm SourceModification) CreatedFiles() (createdFiles []string) {
 	return
 }
 
+// RemovedFiles returns the removed files of the source modification
+func (sm SourceModification) RemovedFiles() (removedFiles []string) {
+	for removed := range sm.removed {
+		removedFiles = append(removedFiles, removed)
+	}
+	return
+}
+
 // AppendModifiedFiles appends modified files in the source modification that are not already documented
 func (sm *SourceModification) AppendModifiedFiles(modifiedFiles ...string) {
 	for _, modifiedFile := range modifiedFiles {
 		_, alreadyModified := sm.modified[modifiedFile]
 		_, alreadyCreated := sm.created[modifiedFile]
-		if !alreadyModified && !alreadyCreated {
+		_, alreadyRemoved := sm.removed[modifiedFile]
+		if !alreadyModified && !alreadyCreated && !alreadyRemoved {
 			sm.modified[modifiedFile] = struct{}{}
 		}
 	}
@@ -45,14 +56,38 @@ func (sm *SourceModification) AppendCreatedFiles(createdFiles ...string) {
 	for _, createdFile := range createdFiles {
 		_, alreadyModified := sm.modified[createdFile]
 		_, alreadyCreated := sm.created[createdFile]
+		_, alreadyRemoved := sm.removed[createdFile]
+
+		// A file removed and created again during the same run is only modified
+		if alreadyRemoved {
+			delete(sm.removed, createdFile)
+			sm.modified[createdFile] = struct{}{}
+			continue
+		}
 		if !alreadyModified && !alreadyCreated {
 			sm.created[createdFile] = struct{}{}
 		}
 	}
 }
 
+// AppendRemovedFiles appends removed files in the source modification, a removed file is no longer documented as
+// modified or created
+func (sm *SourceModification) AppendRemovedFiles(removedFiles ...string) {
+	for _, removedFile := range removedFiles {
+		_, alreadyCreated := sm.created[removedFile]
+		delete(sm.modified, removedFile)
+		delete(sm.created, removedFile)
+
+		// A file created and removed during the same run didn't exist in the source code
+		if !alreadyCreated {
+			sm.removed[removedFile] = struct{}{}
+		}
+	}
+}
+
 // Merge merges new source modification to an existing one
 func (sm *SourceModification) Merge(newSm SourceModification) {
 	sm.AppendModifiedFiles(newSm.ModifiedFiles()...)
 	sm.AppendCreatedFiles(newSm.CreatedFiles()...)
+	sm.AppendRemovedFiles(newSm.RemovedFiles()...)
 }
@@ -22,6 +22,7 @@ func TestNewSourceModification(t *testing.T) {
 	sm := xgenny.NewSourceModification()
 	require.Empty(t, sm.ModifiedFiles())
 	require.Empty(t, sm.CreatedFiles())
+	require.Empty(t, sm.RemovedFiles())
 }
 
 func TestModifiedFiles(t *testing.T) {
@@ -64,6 +65,23 @@ func TestAppendCreatedFiles(t *testing.T) {
 	require.Len(t, sm.ModifiedFiles(), len(modifiedExample)+1)
 }
 
+func TestAppendRemovedFiles(t *testing.T) {
+	sm := sourceModificationExample()
+	sm.AppendRemovedFiles("foo1", "mfoo", "cfoo")
+	require.ElementsMatch(t, sm.RemovedFiles(), []string{"foo1", "mfoo"})
+	require.NotContains(t, sm.ModifiedFiles(), "mfoo")
+	require.NotContains(t, sm.CreatedFiles(), "cfoo")
+
+	// A removed file can't be modified
+	sm.AppendModifiedFiles("foo1")
+	require.Len(t, sm.ModifiedFiles(), len(modifiedExample)-1)
+
+	// A removed file created again is modified
+	sm.AppendCreatedFiles("foo1")
+	require.NotContains(t, sm.RemovedFiles(), "foo1")
+	require.Contains(t, sm.ModifiedFiles(), "foo1")
+}
+
 func TestMerge(t *testing.T) {
 	sm1 := xgenny.NewSourceModification()
 	sm2 := xgenny.NewSourceModification()
@@ -63,6 +63,9 @@ type Chain struct {
 	// protoBuiltAtLeastOnce indicates that app's proto generation at least made once.
 	protoBuiltAtLeastOnce bool
 
+	// pendingContracts are the contracts of config.yml not deployed yet on the genesis being served.
+	pendingContracts []chainconfig.Contract
+
 	stdout, stderr io.Writer
 }
 
@@ -0,0 +1,76 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	chaincmdrunner "github.com/tendermint/starport/starport/pkg/chaincmd/runner"
+)
+
+// contractNodeCheckInterval is the interval between the checks of the node before deploying the contracts
+const contractNodeCheckInterval = time.Second
+
+// deployContracts uploads and instantiates the pending CosmWasm contracts of config.yml once the node has
+// committed its first block, a contract is no longer pending once instantiated
+func (c *Chain) deployContracts(ctx context.Context, commands chaincmdrunner.Runner) error {
+	if err := waitForFirstBlock(ctx, commands); err != nil {
+		return err
+	}
+
+	for len(c.pendingContracts) > 0 {
+		contract := c.pendingContracts[0]
+		msg := []byte("{}")
+		if len(contract.Init) > 0 {
+			var err error
+			if msg, err = json.Marshal(contract.Init); err != nil {
+				return err
+			}
+		}
+
+		codeID, err := commands.WasmStore(ctx, contract.From, filepath.Join(c.app.Path, contract.Path))
+		if err != nil {
+			return err
+		}
+
+		address, err := commands.WasmInstantiate(
+			ctx,
+			contract.From,
+			codeID,
+			string(msg),
+			contract.Label,
+			contract.Funds,
+			contract.Admin,
+		)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(c.stdLog().out, "📜 Contract %s (code %d) instantiated at %s\n", contract.Label, codeID, address)
+		c.pendingContracts = c.pendingContracts[1:]
+	}
+
+	return nil
+}
+
+// waitForFirstBlock waits until the node accepts transactions, the node is checked until it has committed its
+// first block or the context is done
+func waitForFirstBlock(ctx context.Context, commands chaincmdrunner.Runner) error {
+	ticker := time.NewTicker(contractNodeCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// the node isn't reachable until it is started
+		if status, err := commands.Status(ctx); err == nil && status.LatestBlockHeight > 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -334,6 +334,8 @@ func (c *Chain) serve(ctx context.Context, forceReset bool) error {
 	}
 
 	// init phase
+	// the contracts of config.yml are deployed on a new genesis, the restored states already contain the contracts
+	// deployed before and the pending ones are deployed again
 	// nolint:gocritic
 	if !isInit || (appModified && !exportGenesisExists) {
 		fmt.Fprintln(c.stdLog().out, "💿 Initializing the app...")
@@ -341,6 +343,7 @@ func (c *Chain) serve(ctx context.Context, forceReset bool) error {
 		if err := c.Init(ctx, true); err != nil {
 			return err
 		}
+		c.pendingContracts = conf.Contracts
 	} else if appModified {
 		// if the chain is already initialized but the source has been modified
 		// we reset the chain database and import the genesis state
@@ -409,6 +412,19 @@ func (c *Chain) start(ctx context.Context, config chainconfig.Config) error {
 		})
 	}
 
+	// upload and instantiate the pending contracts after genesis, a failure doesn't stop the app and the contracts
+	// not deployed are deployed the next time the app is served.
+	if len(c.pendingContracts) > 0 {
+		g.Go(func() error {
+			err := c.deployContracts(ctx, commands)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				fmt.Fprintf(c.stdLog().err, "%s\n", errorColor("cannot deploy the contracts: "+err.Error()))
+				fmt.Fprintf(c.stdLog().out, "%s\n", infoColor("The contracts not deployed will be deployed on the next restart..."))
+			}
+			return nil
+		})
+	}
+
 	// set the app as being served
 	c.served = true
 
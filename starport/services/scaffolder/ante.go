@@ -0,0 +1,68 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/ante"
+)
+
+// AddAnteDecorator adds a new decorator to the AnteHandler of the app
+func (s Scaffolder) AddAnteDecorator(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	decoratorName string,
+) (sm xgenny.SourceModification, err error) {
+	name, err := multiformatname.NewName(decoratorName)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkGoReservedWord(name.LowerCamel); err != nil {
+		return sm, fmt.Errorf("%s can't be used as a decorator name: %s", name.LowerCamel, err.Error())
+	}
+	if name.Snake == "handler" {
+		return sm, fmt.Errorf("%s can't be used as a decorator name: it is the name of the ante handler", name.LowerCamel)
+	}
+
+	// Check the decorator is not already scaffolded
+	decoratorFile := filepath.Join(s.path, "app", "ante", name.Snake+".go")
+	if _, err := os.Stat(decoratorFile); err == nil {
+		return sm, fmt.Errorf("the decorator %s is already created (%s exists)", name.Kebab, decoratorFile)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	opts := &ante.Options{
+		AppName:       s.modpath.Package,
+		AppPath:       s.path,
+		ModulePath:    s.modpath.RawPath,
+		DecoratorName: name,
+	}
+
+	gens, err := supportAnteHandler(
+		nil,
+		clip,
+		opts.AppPath,
+		opts.ModulePath,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := ante.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
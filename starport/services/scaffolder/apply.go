@@ -0,0 +1,497 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field"
+	modulecreate "github.com/tendermint/starport/starport/templates/module/create"
+)
+
+// Apply scaffolds the modules and the components declared in a schema that are missing in the app.
+// the generators of all the components are run by the runner of the batch and the code generation from the proto
+// files, the tidying and the formatting of the app are run once at the end.
+// the components already scaffolded are skipped, only the new fields of their types are added, so applying
+// an unchanged schema is a no-op. The changes of the existing modules and types that can't be scaffolded are
+// reported before anything is scaffolded.
+func (s Scaffolder) Apply(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	schema Schema,
+) (sm xgenny.SourceModification, err error) {
+	if err := schema.Validate(); err != nil {
+		return sm, err
+	}
+	for _, module := range schema.Modules {
+		if err := checkModuleDrift(ctx, s.path, module); err != nil {
+			return sm, err
+		}
+	}
+
+	batch := s
+	batch.runner = xgenny.NewRunner(clip)
+
+	// The components scaffolded before an error are finished as well to keep the app consistent
+	defer func() {
+		sm = batch.runner.SourceModification()
+		if len(sm.CreatedFiles())+len(sm.ModifiedFiles())+len(sm.RemovedFiles()) == 0 {
+			return
+		}
+		if finishErr := finish(s.path, s.modpath.RawPath); err == nil {
+			err = finishErr
+		}
+	}()
+
+	for _, module := range schema.Modules {
+		if err := batch.applyModule(ctx, clip, module); err != nil {
+			return sm, err
+		}
+	}
+	return sm, nil
+}
+
+// applyModule creates the module if it doesn't exist and scaffolds its missing components.
+func (s Scaffolder) applyModule(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	module SchemaModule,
+) error {
+	mfName, err := multiformatname.NewName(module.Name, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName := mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return err
+	}
+
+	messages := make(map[string]struct{})
+	if ok {
+		if messages, err = protoMessageNames(ctx, s.path, moduleName); err != nil {
+			return err
+		}
+	} else {
+		var options []ModuleCreationOption
+		if module.IBC {
+			options = append(options, WithIBC())
+		}
+		if len(module.Dependencies) > 0 {
+			dependencies, err := parseDependencies(module.Dependencies)
+			if err != nil {
+				return err
+			}
+			options = append(options, WithDependencies(dependencies))
+		}
+
+		if _, err := s.CreateModule(clip, moduleName, options...); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range module.Types {
+		name, err := multiformatname.NewName(t.Name)
+		if err != nil {
+			return err
+		}
+
+		// Only the new fields are added to the existing types
+		if _, ok := messages[name.UpperCamel]; ok {
+			newFields, err := newTypeFields(ctx, s.path, moduleName, name, t.Fields)
+			if err != nil {
+				return err
+			}
+			if len(newFields) == 0 {
+				continue
+			}
+			if _, err := s.AddFields(ctx, clip, moduleName, t.Name, newFields); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var kind AddTypeKind
+		switch t.Kind {
+		case SchemaKindList:
+			kind = ListType()
+		case SchemaKindMap:
+			kind = MapType(t.Indexes...)
+		case SchemaKindSingleton:
+			kind = SingletonType()
+		default:
+			kind = DryType()
+		}
+
+		options := []AddTypeOption{
+			TypeWithModule(moduleName),
+			TypeWithFields(t.Fields...),
+		}
+		if t.NoMessage {
+			options = append(options, TypeWithoutMessage())
+		} else if t.Signer != "" {
+			options = append(options, TypeWithSigner(t.Signer))
+		}
+
+		if _, err := s.AddType(ctx, t.Name, clip, kind, options...); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range module.Messages {
+		name, err := multiformatname.NewName(m.Name)
+		if err != nil {
+			return err
+		}
+		if _, ok := messages["Msg"+name.UpperCamel]; ok {
+			continue
+		}
+
+		var options []MessageOption
+		if m.Description != "" {
+			options = append(options, WithDescription(m.Description))
+		}
+		if m.Signer != "" {
+			options = append(options, WithSigner(m.Signer))
+		}
+
+		if _, err := s.AddMessage(ctx, clip, moduleName, m.Name, m.Fields, m.Response, options...); err != nil {
+			return err
+		}
+	}
+
+	for _, q := range module.Queries {
+		name, err := multiformatname.NewName(q.Name)
+		if err != nil {
+			return err
+		}
+		if _, ok := messages["Query"+name.UpperCamel+"Request"]; ok {
+			continue
+		}
+
+		if _, err := s.AddQuery(ctx, clip, moduleName, q.Name, q.Description, q.Request, q.Response, q.Paginated); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range module.Packets {
+		name, err := multiformatname.NewName(p.Name)
+		if err != nil {
+			return err
+		}
+		if _, ok := messages[name.UpperCamel+"PacketData"]; ok {
+			continue
+		}
+
+		var options []PacketOption
+		if p.NoMessage {
+			options = append(options, PacketWithoutMessage())
+		} else if p.Signer != "" {
+			options = append(options, PacketWithSigner(p.Signer))
+		}
+
+		if _, err := s.AddPacket(ctx, clip, moduleName, p.Name, p.Fields, p.Ack, options...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkModuleDrift checks the options of an existing module and the kind, the indexes, the signer and the fields of
+// its existing types are unchanged in the schema, these changes can't be scaffolded.
+func checkModuleDrift(ctx context.Context, appPath string, module SchemaModule) error {
+	mfName, err := multiformatname.NewName(module.Name, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName := mfName.LowerCase
+
+	ok, err := moduleExists(appPath, moduleName)
+	if err != nil || !ok {
+		return err
+	}
+
+	isIBC, err := isIBCModule(appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if isIBC != module.IBC {
+		return fmt.Errorf("the ibc option of the existing module %s can't be changed to %t", module.Name, module.IBC)
+	}
+
+	dependencies, err := moduleDependencies(appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	declared, err := parseDependencies(module.Dependencies)
+	if err != nil {
+		return err
+	}
+	var declaredNames []string
+	for _, dependency := range declared {
+		declaredNames = append(declaredNames, dependency.Name)
+	}
+	sort.Strings(declaredNames)
+	if strings.Join(dependencies, ",") != strings.Join(declaredNames, ",") {
+		return fmt.Errorf(
+			"the dependencies of the existing module %s can't be changed from [%s] to [%s]",
+			module.Name,
+			strings.Join(dependencies, ", "),
+			strings.Join(declaredNames, ", "),
+		)
+	}
+
+	messages, err := protoMessageNames(ctx, appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	for _, t := range module.Types {
+		if err := checkTypeDrift(ctx, appPath, moduleName, t, messages); err != nil {
+			return fmt.Errorf("the type %s of the module %s: %w", t.Name, module.Name, err)
+		}
+	}
+	return nil
+}
+
+// checkTypeDrift checks the kind, the indexes, the signer and the datatype of the fields of an existing type are
+// unchanged, the datatypes are compared by their proto types.
+func checkTypeDrift(
+	ctx context.Context,
+	appPath,
+	moduleName string,
+	t SchemaType,
+	messages map[string]struct{},
+) error {
+	name, err := multiformatname.NewName(t.Name)
+	if err != nil {
+		return err
+	}
+	if _, ok := messages[name.UpperCamel]; !ok {
+		return nil
+	}
+	protoPath := filepath.Join(appPath, protoFolder, moduleName)
+
+	kind, err := typeKind(ctx, protoPath, name, messages)
+	if err != nil {
+		return err
+	}
+	if kind != t.Kind {
+		return fmt.Errorf("the kind can't be changed from %s to %s", kind, t.Kind)
+	}
+
+	if kind == SchemaKindMap {
+		indexes, err := field.ParseFields(t.Indexes, checkForbiddenTypeIndex)
+		if err != nil {
+			return err
+		}
+		protoIndexes, err := protoanalysis.MessageFields(ctx, protoPath, "QueryGet"+name.UpperCamel+"Request")
+		if err != nil {
+			return err
+		}
+		if !sameProtoFields(indexes, protoIndexes) {
+			return errors.New("the indexes can't be changed")
+		}
+	}
+
+	if _, ok := messages["MsgCreate"+name.UpperCamel]; ok && !t.NoMessage {
+		signer := t.Signer
+		if signer == "" {
+			signer = "creator"
+		}
+		signerName, err := multiformatname.NewName(signer)
+		if err != nil {
+			return err
+		}
+		protoFields, err := protoanalysis.MessageFields(ctx, protoPath, "MsgCreate"+name.UpperCamel)
+		if err != nil {
+			return err
+		}
+		if len(protoFields) > 0 && protoFields[0].Name != signerName.LowerCamel {
+			return fmt.Errorf("the signer can't be changed from %s to %s", protoFields[0].Name, signerName.LowerCamel)
+		}
+	}
+
+	fields, err := field.ParseFields(t.Fields, checkForbiddenTypeIndex)
+	if err != nil {
+		return err
+	}
+	protoFields, err := protoanalysis.MessageFields(ctx, protoPath, name.UpperCamel)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]protoanalysis.MessageField)
+	for _, f := range protoFields {
+		existing[f.Name] = f
+	}
+	for _, f := range fields {
+		protoField, ok := existing[f.Name.LowerCamel]
+		if !ok {
+			continue
+		}
+		if !sameProtoFields(field.Fields{f}, []protoanalysis.MessageField{protoField}) {
+			return fmt.Errorf("the datatype of the field %s can't be changed to %s", f.Name.Original, f.DatatypeName)
+		}
+	}
+	return nil
+}
+
+// typeKind returns the kind of an existing type from the messages of its module: a type without query has no
+// storage, a singleton can't be listed and the count of the values of a list is in the genesis of the module.
+func typeKind(
+	ctx context.Context,
+	protoPath string,
+	name multiformatname.Name,
+	messages map[string]struct{},
+) (string, error) {
+	if _, ok := messages["QueryGet"+name.UpperCamel+"Request"]; !ok {
+		return SchemaKindType, nil
+	}
+	if _, ok := messages["QueryAll"+name.UpperCamel+"Request"]; !ok {
+		return SchemaKindSingleton, nil
+	}
+	genesisFields, err := protoanalysis.MessageFields(ctx, protoPath, "GenesisState")
+	if err != nil {
+		return "", err
+	}
+	for _, f := range genesisFields {
+		if f.Name == name.LowerCamel+"Count" {
+			return SchemaKindList, nil
+		}
+	}
+	return SchemaKindMap, nil
+}
+
+// sameProtoFields checks the fields have the names and the proto types of the fields of a proto message.
+func sameProtoFields(fields field.Fields, protoFields []protoanalysis.MessageField) bool {
+	if len(fields) != len(protoFields) {
+		return false
+	}
+	for i, f := range fields {
+		// The proto type of a field is declared as [repeated] <type> <name> = <number> [<options>]
+		declaration := strings.Fields(f.ProtoType(i + 1))
+		repeated := declaration[0] == "repeated"
+		if repeated {
+			declaration = declaration[1:]
+		}
+		if protoFields[i].Name != f.Name.LowerCamel ||
+			protoFields[i].Type != declaration[0] ||
+			protoFields[i].Repeated != repeated {
+			return false
+		}
+	}
+	return true
+}
+
+// moduleDependencies returns the sorted names of the modules that a module depends on, the keepers of the
+// dependencies are fields of the keeper of the module named after the dependencies.
+func moduleDependencies(appPath, moduleName string) ([]string, error) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, filepath.Join(appPath, moduleDir, moduleName, "keeper", "keeper.go"), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependencies []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		typeSpec, ok := n.(*ast.TypeSpec)
+		if !ok || typeSpec.Name.Name != "Keeper" {
+			return true
+		}
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		for _, structField := range structType.Fields.List {
+			selector, ok := structField.Type.(*ast.SelectorExpr)
+			if !ok || !strings.HasSuffix(selector.Sel.Name, "Keeper") {
+				continue
+			}
+			if pkg, ok := selector.X.(*ast.Ident); !ok || pkg.Name != "types" {
+				continue
+			}
+			for _, fieldName := range structField.Names {
+				if strings.HasSuffix(fieldName.Name, "Keeper") {
+					dependencies = append(dependencies, strings.TrimSuffix(fieldName.Name, "Keeper"))
+				}
+			}
+		}
+		return false
+	})
+	sort.Strings(dependencies)
+	return dependencies, nil
+}
+
+// protoMessageNames returns the names of the messages defined in the proto package of a module
+func protoMessageNames(ctx context.Context, appPath, moduleName string) (map[string]struct{}, error) {
+	pkgs, err := protoanalysis.Parse(ctx, protoanalysis.NewCache(), filepath.Join(appPath, protoFolder, moduleName))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{})
+	for _, pkg := range pkgs {
+		for _, msg := range pkg.Messages {
+			names[msg.Name] = struct{}{}
+		}
+	}
+	return names, nil
+}
+
+// newTypeFields returns the fields declared for a type that are not defined in its proto message yet
+func newTypeFields(
+	ctx context.Context,
+	appPath,
+	moduleName string,
+	typeName multiformatname.Name,
+	fields []string,
+) ([]string, error) {
+	protoFields, err := protoanalysis.MessageFields(ctx, filepath.Join(appPath, protoFolder, moduleName), typeName.UpperCamel)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]struct{})
+	for _, f := range protoFields {
+		existing[f.Name] = struct{}{}
+	}
+
+	var newFields []string
+	for _, f := range fields {
+		name, err := multiformatname.NewName(strings.Split(f, ":")[0])
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := existing[name.LowerCamel]; !ok {
+			newFields = append(newFields, f)
+		}
+	}
+	return newFields, nil
+}
+
+// parseDependencies parses the dependencies of a module declared as <depName> or <depName>:<depKeeperName>
+func parseDependencies(dependencies []string) ([]modulecreate.Dependency, error) {
+	var parsed []modulecreate.Dependency
+	for _, dependency := range dependencies {
+		splitted := strings.Split(dependency, ":")
+		switch len(splitted) {
+		case 1:
+			parsed = append(parsed, modulecreate.NewDependency(splitted[0], ""))
+		case 2:
+			parsed = append(parsed, modulecreate.NewDependency(splitted[0], splitted[1]))
+		default:
+			return nil, fmt.Errorf("dependency %s is invalid, must have <depName> or <depName>:<depKeeperName>", dependency)
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,142 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/blocker"
+)
+
+// blockerOptions represents configuration for the blocker scaffolding
+type blockerOptions struct {
+	queueType string
+}
+
+// BlockerOption configures the blocker scaffolding
+type BlockerOption func(*blockerOptions)
+
+// BlockerWithQueue makes the blocker process a time-ordered queue of items of a type of the module
+func BlockerWithQueue(typeName string) BlockerOption {
+	return func(o *blockerOptions) {
+		o.queueType = typeName
+	}
+}
+
+// AddBlocker adds a new blocker called by the BeginBlock or EndBlock method of a module
+func (s Scaffolder) AddBlocker(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	hook blocker.Hook,
+	moduleName,
+	blockerName string,
+	options ...BlockerOption,
+) (sm xgenny.SourceModification, err error) {
+	var o blockerOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	// If no module is provided, we add the blocker to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(blockerName)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkGoReservedWord(name.LowerCamel); err != nil {
+		return sm, fmt.Errorf("%s can't be used as a blocker name: %s", name.LowerCamel, err.Error())
+	}
+
+	opts := &blocker.Options{
+		AppName:     s.modpath.Package,
+		AppPath:     s.path,
+		ModulePath:  s.modpath.RawPath,
+		ModuleName:  moduleName,
+		OwnerName:   owner(s.modpath.RawPath),
+		Hook:        hook,
+		BlockerName: name,
+	}
+
+	// Check the blocker is not already scaffolded
+	hookName, err := multiformatname.NewName(string(hook))
+	if err != nil {
+		return sm, err
+	}
+	blockerFile := filepath.Join(s.path, "x", moduleName, "keeper", hookName.Snake+"_"+name.Snake+".go")
+	if _, err := os.Stat(blockerFile); err == nil {
+		return sm, fmt.Errorf("the blocker %s is already created (%s exists)", opts.MethodName(), blockerFile)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	// The items of the queue must be of a type of the module
+	if o.queueType != "" {
+		opts.QueueType, err = multiformatname.NewName(o.queueType)
+		if err != nil {
+			return sm, err
+		}
+		protoPath := filepath.Join(s.path, protoFolder, moduleName)
+		if err := protoanalysis.HasMessages(ctx, protoPath, opts.QueueType.UpperCamel); err != nil {
+			return sm, fmt.Errorf("the queue type %s must be a type of the module: %w", o.queueType, err)
+		}
+
+		// The items of the queue exported in the genesis reference the queue type
+		opts.QueueTypeProtoImport, err = protoMessageImport(ctx, s.path, moduleName, opts.QueueType.UpperCamel)
+		if err != nil {
+			return sm, err
+		}
+	}
+
+	g, err := blocker.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(clip, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
+
+// protoMessageImport returns the import path of the proto file of a module defining a message
+func protoMessageImport(ctx context.Context, appPath, moduleName, messageName string) (string, error) {
+	protoPath := filepath.Join(appPath, protoFolder)
+	pkgs, err := protoanalysis.Parse(ctx, protoanalysis.NewCache(), filepath.Join(protoPath, moduleName))
+	if err != nil {
+		return "", err
+	}
+	for _, pkg := range pkgs {
+		for _, msg := range pkg.Messages {
+			if msg.Name != messageName {
+				continue
+			}
+			path, err := filepath.Rel(protoPath, msg.Path)
+			if err != nil {
+				return "", err
+			}
+			return filepath.ToSlash(path), nil
+		}
+	}
+	return "", fmt.Errorf("the proto message %s is not defined in the module %s", messageName, moduleName)
+}
@@ -9,24 +9,37 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/tendermint/starport/starport/templates/enum"
+	"github.com/tendermint/starport/starport/templates/field"
 	"github.com/tendermint/starport/starport/templates/field/datatype"
 )
 
 const (
-	componentType    = "type"
-	componentMessage = "message"
-	componentQuery   = "query"
-	componentPacket  = "packet"
+	componentType     = "type"
+	componentMessage  = "message"
+	componentQuery    = "query"
+	componentPacket   = "packet"
+	componentEvent    = "event"
+	componentProposal = "proposal"
 
 	protoFolder = "proto"
 )
 
 // checkComponentValidity performs various checks common to all components to verify if it can be scaffolded
-func checkComponentValidity(appPath, moduleName string, compName multiformatname.Name, noMessage bool) error {
+// the existing types are the types the component is allowed to reuse, e.g. the proto message a type is scaffolded from
+func checkComponentValidity(
+	appPath,
+	moduleName string,
+	compName multiformatname.Name,
+	noMessage bool,
+	existingTypes ...string,
+) error {
 	ok, err := moduleExists(appPath, moduleName)
 	if err != nil {
 		return err
@@ -41,7 +54,7 @@ func checkComponentValidity(appPath, moduleName string, compName multiformatname
 	}
 
 	// Check component name is not already used
-	return checkComponentCreated(appPath, moduleName, compName, noMessage)
+	return checkComponentCreated(appPath, moduleName, compName, noMessage, existingTypes...)
 }
 
 // checkForbiddenComponentName returns true if the name is forbidden as a component name
@@ -119,7 +132,13 @@ func checkGoReservedWord(name string) error {
 }
 
 // checkComponentCreated checks if the component has been already created with Starport in the project
-func checkComponentCreated(appPath, moduleName string, compName multiformatname.Name, noMessage bool) (err error) {
+func checkComponentCreated(
+	appPath,
+	moduleName string,
+	compName multiformatname.Name,
+	noMessage bool,
+	existingTypes ...string,
+) error {
 
 	// associate the type to check with the component that scaffold this type
 	typesToCheck := map[string]string{
@@ -131,6 +150,8 @@ func checkComponentCreated(appPath, moduleName string, compName multiformatname.
 		"Query" + compName.UpperCamel + "Request":     componentQuery,
 		"Query" + compName.UpperCamel + "Response":    componentQuery,
 		compName.UpperCamel + "PacketData":            componentPacket,
+		"Event" + compName.UpperCamel:                 componentEvent,
+		compName.UpperCamel + "Proposal":              componentProposal,
 	}
 
 	if !noMessage {
@@ -139,18 +160,52 @@ func checkComponentCreated(appPath, moduleName string, compName multiformatname.
 		typesToCheck["MsgDelete"+compName.UpperCamel] = componentType
 		typesToCheck["Msg"+compName.UpperCamel] = componentMessage
 		typesToCheck["MsgSend"+compName.UpperCamel] = componentPacket
+		typesToCheck["Event"+compName.UpperCamel+"Created"] = componentType
+		typesToCheck["Event"+compName.UpperCamel+"Updated"] = componentType
+		typesToCheck["Event"+compName.UpperCamel+"Deleted"] = componentType
+	}
+	for _, name := range existingTypes {
+		delete(typesToCheck, name)
 	}
 
-	absPath, err := filepath.Abs(filepath.Join(appPath, "x", moduleName, "types"))
+	structTypes, err := moduleStructTypes(appPath, moduleName)
 	if err != nil {
 		return err
 	}
+
+	// sort the types to always report the same one
+	names := make([]string, 0, len(typesToCheck))
+	for name := range typesToCheck {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// Check if the parsed type is from a scaffolded component with the name
+		if _, ok := structTypes[name]; ok {
+			return fmt.Errorf("component %s with name %s is already created (type %s exists)",
+				typesToCheck[name],
+				compName.Original,
+				name,
+			)
+		}
+	}
+	return nil
+}
+
+// moduleStructTypes returns the struct types defined in the types package of the module by name
+func moduleStructTypes(appPath, moduleName string) (map[string]*ast.StructType, error) {
+	absPath, err := filepath.Abs(filepath.Join(appPath, "x", moduleName, "types"))
+	if err != nil {
+		return nil, err
+	}
 	fileSet := token.NewFileSet()
 	all, err := parser.ParseDir(fileSet, absPath, func(os.FileInfo) bool { return true }, parser.ParseComments)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	structTypes := make(map[string]*ast.StructType)
 	for _, pkg := range all {
 		for _, f := range pkg.Files {
 			ast.Inspect(f, func(x ast.Node) bool {
@@ -159,28 +214,14 @@ func checkComponentCreated(appPath, moduleName string, compName multiformatname.
 					return true
 				}
 
-				if _, ok := typeSpec.Type.(*ast.StructType); !ok {
-					return true
-				}
-
-				// Check if the parsed type is from a scaffolded component with the name
-				if compType, ok := typesToCheck[typeSpec.Name.Name]; ok {
-					err = fmt.Errorf("component %s with name %s is already created (type %s exists)",
-						compType,
-						compName.Original,
-						typeSpec.Name.Name,
-					)
-					return false
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					structTypes[typeSpec.Name.Name] = structType
 				}
-
 				return true
 			})
-			if err != nil {
-				return
-			}
 		}
 	}
-	return err
+	return structTypes, nil
 }
 
 // checkForbiddenOracleFieldName returns true if the name is forbidden as an oracle field name
@@ -207,19 +248,202 @@ func checkForbiddenOracleFieldName(name string) error {
 	return nil
 }
 
-// checkCustomTypes returns error if one of the types is invalid
-func checkCustomTypes(ctx context.Context, path, module string, fields []string) error {
+// checkCustomTypes returns error if one of the custom types is invalid
+// the fields of the custom types are analyzed to generate the test values of the nested types
+func checkCustomTypes(ctx context.Context, path, module string, fields field.Fields) error {
 	protoPath := filepath.Join(path, protoFolder, module)
-	customFields := make([]string, 0)
-	for _, name := range fields {
-		fieldSplit := strings.Split(name, datatype.Separator)
-		if len(fieldSplit) <= 1 {
+	for i, f := range fields {
+		if !f.IsCustom() {
 			continue
 		}
-		fieldType := datatype.Name(fieldSplit[1])
-		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
-			customFields = append(customFields, string(fieldType))
+		if err := protoanalysis.HasMessages(ctx, protoPath, f.Datatype); err != nil {
+			return err
+		}
+		nested, err := nestedFields(ctx, protoPath, f.Datatype, make(map[string]struct{}))
+		if err != nil {
+			return err
+		}
+		fields[i].Nested = nested
+	}
+	return nil
+}
+
+// nestedFields returns the fields of a custom type defined in the proto package of the module
+// the fields with a type unknown to the scaffolder are skipped, they keep their zero value in tests
+func nestedFields(
+	ctx context.Context,
+	protoPath,
+	typeName string,
+	parents map[string]struct{},
+) (field.Fields, error) {
+	// A type referencing one of its parents is not analyzed again to avoid an infinite recursion
+	if _, ok := parents[typeName]; ok {
+		return field.Fields{}, nil
+	}
+	parents[typeName] = struct{}{}
+	defer delete(parents, typeName)
+
+	messageFields, err := protoanalysis.MessageFields(ctx, protoPath, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(field.Fields, 0, len(messageFields))
+	for _, messageField := range messageFields {
+		name, err := multiformatname.NewName(messageField.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		datatypeName, ok, err := protoFieldDatatype(messageField)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			fields = append(fields, field.Field{
+				Name:         name,
+				DatatypeName: datatypeName,
+			})
+			continue
+		}
+
+		// The type of the field is another custom type of the module
+		if err := protoanalysis.HasMessages(ctx, protoPath, messageField.Type); err != nil {
+			continue
+		}
+		nested, err := nestedFields(ctx, protoPath, messageField.Type, parents)
+		if err != nil {
+			return nil, err
+		}
+		datatypeName = datatype.Custom
+		if messageField.Repeated {
+			datatypeName = datatype.CustomSlice
+		}
+		fields = append(fields, field.Field{
+			Name:         name,
+			Datatype:     messageField.Type,
+			DatatypeName: datatypeName,
+			Nested:       nested,
+		})
+	}
+	return fields, nil
+}
+
+// protoFieldOptions are the gogoproto options of the proto fields of the scaffolder types, the generated Go type of
+// a field without them is not the Go type of the scaffolder type.
+var protoFieldOptions = map[datatype.Name][][2]string{
+	datatype.Decimal:   {{"(gogoproto.nullable)", "false"}},
+	datatype.Coin:      {{"(gogoproto.nullable)", "false"}},
+	datatype.Timestamp: {{"(gogoproto.nullable)", "false"}, {"(gogoproto.stdtime)", "true"}},
+}
+
+// protoFieldDatatype returns the scaffolder type of a proto field, false if the type is not a scaffolder type
+// an error is returned if the field has the proto type of a scaffolder type without its gogoproto options.
+func protoFieldDatatype(messageField protoanalysis.MessageField) (datatype.Name, bool, error) {
+	var datatypeName datatype.Name
+	switch messageField.Type {
+	case "string":
+		datatypeName = datatype.String
+		if messageField.Options["(gogoproto.customtype)"] == "github.com/cosmos/cosmos-sdk/types.Dec" {
+			datatypeName = datatype.Decimal
+		}
+	case "bool":
+		datatypeName = datatype.Bool
+	case "int32":
+		datatypeName = datatype.Int
+	case "uint64":
+		datatypeName = datatype.Uint
+	case "bytes":
+		datatypeName = datatype.Bytes
+	case "cosmos.base.v1beta1.Coin":
+		datatypeName = datatype.Coin
+	case "google.protobuf.Timestamp":
+		datatypeName = datatype.Timestamp
+	default:
+		return "", false, nil
+	}
+	for _, option := range protoFieldOptions[datatypeName] {
+		if messageField.Options[option[0]] != option[1] {
+			return "", false, fmt.Errorf(
+				"the %s field %s must have the option %s = %s to be used as a %s",
+				messageField.Type,
+				messageField.Name,
+				option[0],
+				option[1],
+				datatypeName,
+			)
+		}
+	}
+	if !messageField.Repeated {
+		return datatypeName, true, nil
+	}
+
+	switch datatypeName {
+	case datatype.String:
+		return datatype.StringSlice, true, nil
+	case datatype.Int:
+		return datatype.IntSlice, true, nil
+	case datatype.Uint:
+		return datatype.UintSlice, true, nil
+	case datatype.Coin:
+		return datatype.Coins, true, nil
+	}
+	return "", false, nil
+}
+
+// supportEnums appends the generators to scaffold the enums declared by the fields
+// an enum can't have the name of a type already defined in the module and the fields declaring an enum with the
+// name of an existing enum must declare its values
+func supportEnums(
+	ctx context.Context,
+	gens []*genny.Generator,
+	appPath,
+	appName,
+	modulePath,
+	moduleName string,
+	fields ...field.Fields,
+) ([]*genny.Generator, error) {
+	protoPath := filepath.Join(appPath, protoFolder, moduleName)
+	declared := make(map[string][]string)
+	for _, f := range fields {
+		for _, enumField := range f.Enums() {
+			if err := protoanalysis.HasMessages(ctx, protoPath, enumField.Datatype); err == nil {
+				return gens, fmt.Errorf("the enum %s can't be declared, %s is a type of the module", enumField.Name.Original, enumField.Datatype)
+			}
+
+			values := enumField.EnumValueNames()
+			existing, ok := declared[enumField.Datatype]
+			if !ok {
+				var err error
+				existing, err = protoanalysis.EnumValues(ctx, protoPath, enumField.Datatype)
+				ok = err == nil
+			}
+			if ok && strings.Join(existing, ",") != strings.Join(values, ",") {
+				return gens, fmt.Errorf(
+					"the enum %s is already declared with the values %s, the field %s must declare the same values",
+					enumField.Datatype,
+					strings.Join(existing, ", "),
+					enumField.Name.Original,
+				)
+			}
+			if ok {
+				continue
+			}
+			declared[enumField.Datatype] = values
+
+			g, err := enum.NewStargate(&enum.Options{
+				AppName:    appName,
+				AppPath:    appPath,
+				ModuleName: moduleName,
+				ModulePath: modulePath,
+				OwnerName:  owner(modulePath),
+				Enum:       enumField,
+			})
+			if err != nil {
+				return gens, err
+			}
+			gens = append(gens, g)
 		}
 	}
-	return protoanalysis.HasMessages(ctx, protoPath, customFields...)
+	return gens, nil
 }
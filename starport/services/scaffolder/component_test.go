@@ -0,0 +1,132 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+func TestProtoFieldDatatype(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    protoanalysis.MessageField
+		datatype datatype.Name
+		ok       bool
+		err      string
+	}{
+		{
+			name:     "string",
+			field:    protoanalysis.MessageField{Name: "title", Type: "string"},
+			datatype: datatype.String,
+			ok:       true,
+		},
+		{
+			name:     "repeated uint64",
+			field:    protoanalysis.MessageField{Name: "ids", Type: "uint64", Repeated: true},
+			datatype: datatype.UintSlice,
+			ok:       true,
+		},
+		{
+			name: "decimal",
+			field: protoanalysis.MessageField{Name: "price", Type: "string", Options: map[string]string{
+				"(gogoproto.customtype)": "github.com/cosmos/cosmos-sdk/types.Dec",
+				"(gogoproto.nullable)":   "false",
+			}},
+			datatype: datatype.Decimal,
+			ok:       true,
+		},
+		{
+			name: "coins",
+			field: protoanalysis.MessageField{Name: "amount", Type: "cosmos.base.v1beta1.Coin", Repeated: true, Options: map[string]string{
+				"(gogoproto.nullable)": "false",
+			}},
+			datatype: datatype.Coins,
+			ok:       true,
+		},
+		{
+			name: "timestamp",
+			field: protoanalysis.MessageField{Name: "at", Type: "google.protobuf.Timestamp", Options: map[string]string{
+				"(gogoproto.nullable)": "false",
+				"(gogoproto.stdtime)":  "true",
+			}},
+			datatype: datatype.Timestamp,
+			ok:       true,
+		},
+		{
+			name:  "custom type",
+			field: protoanalysis.MessageField{Name: "author", Type: "Author"},
+		},
+		{
+			name: "repeated timestamp",
+			field: protoanalysis.MessageField{Name: "at", Type: "google.protobuf.Timestamp", Repeated: true, Options: map[string]string{
+				"(gogoproto.nullable)": "false",
+				"(gogoproto.stdtime)":  "true",
+			}},
+		},
+		{
+			name:  "nullable coin",
+			field: protoanalysis.MessageField{Name: "amount", Type: "cosmos.base.v1beta1.Coin"},
+			err:   "the cosmos.base.v1beta1.Coin field amount must have the option (gogoproto.nullable) = false to be used as a coin",
+		},
+		{
+			name: "timestamp without stdtime",
+			field: protoanalysis.MessageField{Name: "at", Type: "google.protobuf.Timestamp", Options: map[string]string{
+				"(gogoproto.nullable)": "false",
+			}},
+			err: "the google.protobuf.Timestamp field at must have the option (gogoproto.stdtime) = true to be used as a timestamp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			datatypeName, ok, err := protoFieldDatatype(tt.field)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.datatype, datatypeName)
+		})
+	}
+}
+
+func TestSupportEnums(t *testing.T) {
+	appPath := t.TempDir()
+	protoPath := filepath.Join(appPath, protoFolder, "blog")
+	require.NoError(t, os.MkdirAll(protoPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(protoPath, "status.proto"), []byte(`syntax = "proto3";
+package test.blog.blog;
+
+enum Status {
+  STATUS_DRAFT = 0;
+  STATUS_PUBLISHED = 1;
+}
+`), 0644))
+
+	parse := func(fields ...string) field.Fields {
+		parsed, err := field.ParseFields(fields, func(string) error { return nil })
+		require.NoError(t, err)
+		return parsed
+	}
+	support := func(fields ...field.Fields) error {
+		_, err := supportEnums(context.Background(), nil, appPath, "blog", "github.com/test/blog", "blog", fields...)
+		return err
+	}
+
+	require.NoError(t, support(parse("status:enum(draft,published)")))
+	require.EqualError(t,
+		support(parse("status:enum(draft,archived)")),
+		"the enum Status is already declared with the values STATUS_DRAFT, STATUS_PUBLISHED, the field status must declare the same values",
+	)
+	require.NoError(t, support(parse("kind:enum(a,b)"), parse("kind:enum(a,b)")))
+	require.EqualError(t,
+		support(parse("kind:enum(a,b)"), parse("kind:enum(b,a)")),
+		"the enum Kind is already declared with the values KIND_A, KIND_B, the field kind must declare the same values",
+	)
+}
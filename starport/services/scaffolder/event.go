@@ -0,0 +1,97 @@
+package scaffolder
+
+import (
+	"context"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/event"
+	"github.com/tendermint/starport/starport/templates/field"
+)
+
+// AddEvent adds a new typed event to scaffolded app
+func (s Scaffolder) AddEvent(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	moduleName,
+	eventName string,
+	fields []string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the type to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(eventName)
+	if err != nil {
+		return sm, err
+	}
+
+	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
+		return sm, err
+	}
+
+	// Check and parse provided fields
+	parsedFields, err := field.ParseFields(fields, checkGoReservedWord)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedFields); err != nil {
+		return sm, err
+	}
+
+	var (
+		g    *genny.Generator
+		opts = &event.Options{
+			AppName:    s.modpath.Package,
+			AppPath:    s.path,
+			ModulePath: s.modpath.RawPath,
+			ModuleName: moduleName,
+			OwnerName:  owner(s.modpath.RawPath),
+			EventName:  name,
+			Fields:     parsedFields,
+		}
+	)
+
+	gens, err := supportEvents(
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	// Scaffold
+	g, err = event.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+	gens, err = supportEnums(
+		ctx,
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+	)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
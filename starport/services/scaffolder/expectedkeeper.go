@@ -0,0 +1,62 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/keeper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/expectedkeeper"
+)
+
+// FillExpectedKeepers declares in the expected keepers of a module the methods called by the module on the keepers
+// it depends on and generates the mocks of the expected keepers for the tests of the keeper
+func (s Scaffolder) FillExpectedKeepers(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	moduleName string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we fill the expected keepers of the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	module, err := keeper.Discover(ctx, s.path, s.modpath.RawPath, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if len(module.Dependencies) == 0 {
+		return sm, fmt.Errorf("the module %s doesn't depend on the keeper of another module", moduleName)
+	}
+
+	opts := &expectedkeeper.Options{
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		Module:     module,
+	}
+	g, err := expectedkeeper.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(clip, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
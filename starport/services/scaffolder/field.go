@@ -0,0 +1,130 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/remove"
+	"github.com/tendermint/starport/starport/templates/typed/extend"
+)
+
+// AddFields adds new fields to a type already scaffolded in a module of the app.
+// the fields are added to the type and to the messages, CLI commands and tests scaffolded with it.
+// if no module is given, the type is looked for in the app's default module.
+func (s Scaffolder) AddFields(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	moduleName,
+	typeName string,
+	fields []string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we look for the type in the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return sm, err
+	}
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	kind, noMessage, err := componentKind(s.path, moduleName, name)
+	if err != nil {
+		return sm, err
+	}
+	switch kind {
+	case remove.KindList, remove.KindMap, remove.KindSingleton, remove.KindDry:
+	default:
+		return sm, fmt.Errorf("%s is a %s, fields can only be added to a type", name.Original, kind)
+	}
+
+	// The new fields can't have the name of the existing ones
+	existingFields, err := typeFieldNames(s.path, moduleName, name)
+	if err != nil {
+		return sm, err
+	}
+
+	// Check and parse provided fields
+	tFields, err := field.ParseFields(fields, checkForbiddenTypeField, existingFields...)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkCustomTypes(ctx, s.path, moduleName, tFields); err != nil {
+		return sm, err
+	}
+
+	opts := &extend.Options{
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		TypeName:   name,
+		Fields:     tFields,
+		NoMessage:  noMessage,
+	}
+	gens, err := supportEnums(
+		ctx,
+		[]*genny.Generator{extend.NewStargate(clip, opts)},
+		s.path,
+		s.modpath.Package,
+		s.modpath.RawPath,
+		moduleName,
+		tFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = s.run(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finishOrDefer()
+}
+
+// typeFieldNames returns the names of the fields of a type defined in the types package of the module
+func typeFieldNames(appPath, moduleName string, typeName multiformatname.Name) ([]string, error) {
+	structTypes, err := moduleStructTypes(appPath, moduleName)
+	if err != nil {
+		return nil, err
+	}
+	structType, ok := structTypes[typeName.UpperCamel]
+	if !ok {
+		return nil, fmt.Errorf("type %s not found in the module %s", typeName.Original, moduleName)
+	}
+
+	var names []string
+	for _, f := range structType.Fields.List {
+		for _, ident := range f.Names {
+			// Skip the internal fields of the generated protobuf types
+			if strings.HasPrefix(ident.Name, "XXX_") {
+				continue
+			}
+
+			fieldName, err := multiformatname.NewName(ident.Name)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, fieldName.LowerCamel)
+		}
+	}
+	return names, nil
+}
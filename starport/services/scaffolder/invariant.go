@@ -0,0 +1,84 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/invariant"
+)
+
+// AddInvariant adds a new invariant registered by a module and checked by the crisis module of the app
+func (s Scaffolder) AddInvariant(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	moduleName,
+	invariantName string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the invariant to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(invariantName)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkGoReservedWord(name.LowerCamel); err != nil {
+		return sm, fmt.Errorf("%s can't be used as an invariant name: %s", name.LowerCamel, err.Error())
+	}
+
+	// Check the invariant is not already scaffolded
+	invariantFile := filepath.Join(s.path, moduleDir, moduleName, "keeper", "invariant_"+name.Snake+".go")
+	if _, err := os.Stat(invariantFile); err == nil {
+		return sm, fmt.Errorf("the invariant %s is already created (%s exists)", name.Kebab, invariantFile)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	opts := &invariant.Options{
+		AppName:       s.modpath.Package,
+		AppPath:       s.path,
+		ModulePath:    s.modpath.RawPath,
+		ModuleName:    moduleName,
+		InvariantName: name,
+	}
+
+	gens, err := supportKeeperExport(
+		nil,
+		opts.AppPath,
+		opts.ModuleName,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := invariant.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
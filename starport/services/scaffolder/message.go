@@ -81,22 +81,22 @@ func (s Scaffolder) AddMessage(
 	}
 
 	// Check and parse provided fields
-	if err := checkCustomTypes(ctx, s.path, moduleName, fields); err != nil {
-		return sm, err
-	}
 	parsedMsgFields, err := field.ParseFields(fields, checkForbiddenMessageField, scaffoldingOpts.signer)
 	if err != nil {
 		return sm, err
 	}
-
-	// Check and parse provided response fields
-	if err := checkCustomTypes(ctx, s.path, moduleName, resFields); err != nil {
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedMsgFields); err != nil {
 		return sm, err
 	}
+
+	// Check and parse provided response fields
 	parsedResFields, err := field.ParseFields(resFields, checkGoReservedWord, scaffoldingOpts.signer)
 	if err != nil {
 		return sm, err
 	}
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedResFields); err != nil {
+		return sm, err
+	}
 
 	mfSigner, err := multiformatname.NewName(scaffoldingOpts.signer)
 	if err != nil {
@@ -147,17 +147,41 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	gens, err = supportEvents(
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	// Scaffold
 	g, err = message.NewStargate(clip, opts)
 	if err != nil {
 		return sm, err
 	}
 	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(clip, gens...)
+	gens, err = supportEnums(
+		ctx,
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.ResFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = s.run(clip, gens...)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(opts.AppPath, s.modpath.RawPath)
+	return sm, s.finishOrDefer()
 }
 
 // checkForbiddenMessageField returns true if the name is forbidden as a message name
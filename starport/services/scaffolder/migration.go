@@ -0,0 +1,108 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/migration"
+)
+
+// AddMigration adds a new store migration to a module and bumps the consensus version of the module
+func (s Scaffolder) AddMigration(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	moduleName string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the migration to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	version, err := moduleConsensusVersion(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
+	opts := &migration.Options{
+		AppName:     s.modpath.Package,
+		AppPath:     s.path,
+		ModulePath:  s.modpath.RawPath,
+		ModuleName:  moduleName,
+		FromVersion: version,
+	}
+
+	gens, err := supportMigrator(
+		nil,
+		opts.AppPath,
+		opts.ModulePath,
+		opts.ModuleName,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := migration.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
+
+// moduleConsensusVersion returns the consensus version returned by the ConsensusVersion method of the module
+func moduleConsensusVersion(appPath, moduleName string) (uint64, error) {
+	path := filepath.Join(appPath, moduleDir, moduleName, "module.go")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	// The returned value is read by the selector and left as is
+	var returned string
+	if _, err := clipper.New().ReplaceCodeSnippetsAt(
+		path,
+		string(content),
+		clipper.GoSelectReturnedValues,
+		clipper.SelectOptions{
+			"functionName": "ConsensusVersion",
+			"receiverType": "AppModule",
+		},
+		func(data interface{}) string {
+			returned = data.(string)
+			return returned
+		},
+	); err != nil {
+		return 0, err
+	}
+
+	version, err := strconv.ParseUint(strings.TrimSpace(returned), 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cannot find the consensus version of the module %s in %s", moduleName, path)
+	}
+	return version, nil
+}
@@ -7,16 +7,17 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/clipper"
-	"github.com/tendermint/starport/starport/pkg/cmdrunner"
-	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 	appanalysis "github.com/tendermint/starport/starport/pkg/cosmosanalysis/app"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/wiring"
 	"github.com/tendermint/starport/starport/pkg/cosmosver"
 	"github.com/tendermint/starport/starport/pkg/gocmd"
+	"github.com/tendermint/starport/starport/pkg/gomodule"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/pkg/validation"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
@@ -27,12 +28,15 @@ import (
 )
 
 const (
-	wasmImport    = "github.com/CosmWasm/wasmd"
-	wasmVersion   = "v0.16.0"
-	extrasImport  = "github.com/tendermint/spm-extras"
-	extrasVersion = "v0.1.0"
-	appPkg        = "app"
-	moduleDir     = "x"
+	wasmImport  = "github.com/CosmWasm/wasmd"
+	wasmVersion = "v0.22.0"
+	appPkg      = "app"
+	moduleDir   = "x"
+
+	// wasmSDKVersion and wasmIBCModule are the version of the Cosmos SDK and the Go module of ibc-go required by
+	// the version of wasmd installed
+	wasmSDKVersion = "v0.45.0"
+	wasmIBCModule  = "github.com/cosmos/ibc-go/v2"
 )
 
 var (
@@ -99,6 +103,9 @@ type moduleCreationOptions struct {
 	// ibcChannelOrdering ibc channel ordering
 	ibcChannelOrdering string
 
+	// ibcMiddleware name of the app wrapped by the ibc middleware of the module
+	ibcMiddleware string
+
 	// dependencies list of module dependencies
 	dependencies []modulecreate.Dependency
 }
@@ -134,6 +141,13 @@ func WithIBCChannelOrdering(ordering string) ModuleCreationOption {
 	}
 }
 
+// WithIBCMiddleware scaffolds a middleware wrapping the IBC module of an app, only the transfer app is supported
+func WithIBCMiddleware(app string) ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.ibcMiddleware = app
+	}
+}
+
 // WithDependencies specifies the name of the modules that the module depends on
 func WithDependencies(dependencies []modulecreate.Dependency) ModuleCreationOption {
 	return func(m *moduleCreationOptions) {
@@ -178,22 +192,35 @@ func (s Scaffolder) CreateModule(
 	if err != nil {
 		return sm, err
 	}
+	if enums := params.Enums(); len(enums) > 0 {
+		return sm, fmt.Errorf("the param %s can't be an enum", enums[0].Name.Original)
+	}
 
 	// Check dependencies
 	if err := checkDependencies(creationOpts.dependencies, s.path); err != nil {
 		return sm, err
 	}
 
+	// Check the IBC middleware
+	if creationOpts.ibcMiddleware != "" && creationOpts.ibcMiddleware != modulecreate.IBCMiddlewareTransfer {
+		return sm, fmt.Errorf(
+			"the IBC middleware %s is not supported. Supported middleware: %s",
+			creationOpts.ibcMiddleware,
+			modulecreate.IBCMiddlewareTransfer,
+		)
+	}
+
 	opts := &modulecreate.CreateOptions{
-		ModuleName:   moduleName,
-		ModulePath:   s.modpath.RawPath,
-		Params:       params,
-		AppName:      s.modpath.Package,
-		AppPath:      s.path,
-		OwnerName:    owner(s.modpath.RawPath),
-		IsIBC:        creationOpts.ibc,
-		IBCOrdering:  creationOpts.ibcChannelOrdering,
-		Dependencies: creationOpts.dependencies,
+		ModuleName:    moduleName,
+		ModulePath:    s.modpath.RawPath,
+		Params:        params,
+		AppName:       s.modpath.Package,
+		AppPath:       s.path,
+		OwnerName:     owner(s.modpath.RawPath),
+		IsIBC:         creationOpts.ibc,
+		IBCOrdering:   creationOpts.ibcChannelOrdering,
+		IBCMiddleware: creationOpts.ibcMiddleware,
+		Dependencies:  creationOpts.dependencies,
 	}
 
 	// Generator from Cosmos SDK version
@@ -211,24 +238,33 @@ func (s Scaffolder) CreateModule(
 		}
 		gens = append(gens, g)
 	}
-	sm, err = xgenny.RunWithValidation(clip, gens...)
+
+	// Scaffold the IBC middleware of the module
+	if opts.IBCMiddleware != "" {
+		g, err = modulecreate.NewIBCMiddleware(opts)
+		if err != nil {
+			return sm, err
+		}
+		gens = append(gens, g)
+	}
+	sm, err = s.run(clip, gens...)
 	if err != nil {
 		return sm, err
 	}
 
 	// Modify app.go to register the module
-	newSourceModification, runErr := xgenny.RunWithValidation(clip, modulecreate.NewStargateAppModify(clip, opts))
+	newSourceModification, runErr := s.run(clip, modulecreate.NewStargateAppModify(clip, opts))
 	sm.Merge(newSourceModification)
 	var validationErr validation.Error
 	if runErr != nil && !errors.As(runErr, &validationErr) {
 		return sm, runErr
 	}
 
-	return sm, finish(opts.AppPath, s.modpath.RawPath)
+	return sm, s.finishOrDefer()
 }
 
 // ImportModule imports specified module with name to the scaffolded app.
-func (s Scaffolder) ImportModule(clip *clipper.Clipper, name string) (sm xgenny.SourceModification, err error) {
+func (s Scaffolder) ImportModule(ctx context.Context, clip *clipper.Clipper, name string) (sm xgenny.SourceModification, err error) {
 	// Only wasm is currently supported
 	if name != "wasm" {
 		return sm, errors.New("module cannot be imported. Supported module: wasm")
@@ -242,6 +278,38 @@ func (s Scaffolder) ImportModule(clip *clipper.Clipper, name string) (sm xgenny.
 		return sm, errors.New("wasm is already imported")
 	}
 
+	// the version of wasmd installed requires the Cosmos SDK v0.45 and ibc-go v2, the app isn't upgraded
+	// to use them as its modules might not support them yet
+	sdkVersion, err := cosmosver.Parse(wasmSDKVersion)
+	if err != nil {
+		return sm, err
+	}
+	if s.Version.LT(sdkVersion) {
+		return sm, fmt.Errorf(
+			"wasmd %s requires the Cosmos SDK %s or later but the app uses the Cosmos SDK %s, upgrade the app to import wasm",
+			wasmVersion,
+			wasmSDKVersion,
+			s.Version.Version,
+		)
+	}
+	ibcRequired, err := isRequired(s.path, wasmIBCModule)
+	if err != nil {
+		return sm, err
+	}
+	if !ibcRequired {
+		return sm, fmt.Errorf(
+			"wasmd %s requires %s but the app doesn't use it, upgrade the app to import wasm",
+			wasmVersion,
+			wasmIBCModule,
+		)
+	}
+
+	// the wasm decorators are chained in the ante handler of the app
+	gens, err := supportAnteHandler(nil, clip, s.path, s.modpath.RawPath)
+	if err != nil {
+		return sm, err
+	}
+
 	// run generator
 	g, err := moduleimport.NewStargate(clip, &moduleimport.ImportOptions{
 		AppPath:          s.path,
@@ -252,8 +320,9 @@ func (s Scaffolder) ImportModule(clip *clipper.Clipper, name string) (sm xgenny.
 	if err != nil {
 		return sm, err
 	}
+	gens = append(gens, g)
 
-	sm, err = xgenny.RunWithValidation(clip, g)
+	sm, err = xgenny.RunWithValidation(clip, gens...)
 	if err != nil {
 		var validationErr validation.Error
 		if errors.As(err, &validationErr) {
@@ -265,13 +334,134 @@ func (s Scaffolder) ImportModule(clip *clipper.Clipper, name string) (sm xgenny.
 
 	// import a specific version of ComsWasm
 	// NOTE(dshulyak) it must be installed after validation
-	if err := s.installWasm(); err != nil {
+	if err := gocmd.Get(ctx, s.path, []string{gocmd.PackageLiteral(wasmImport, wasmVersion)}); err != nil {
 		return sm, err
 	}
 
 	return sm, finish(s.path, s.modpath.RawPath)
 }
 
+// ImportGoModule imports the module of a Go package with an optional version, e.g.
+// github.com/cosmos/ibc-go/v2/modules/apps/transfer@v2.0.0, to the scaffolded app: the Go module of the package is
+// added to the requirements of the app and the module is wired into the app. When the module can't be wired
+// automatically, the app is left as is, go.mod and go.sum included, and the steps to wire it manually are returned.
+func (s Scaffolder) ImportGoModule(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	pkg string,
+) (sm xgenny.SourceModification, checklist []string, err error) {
+	pkgPath, version := pkg, ""
+	if i := strings.LastIndex(pkg, "@"); i >= 0 {
+		pkgPath, version = pkg[:i], pkg[i+1:]
+		if version == "" {
+			return sm, nil, fmt.Errorf("the version of %s is empty", pkg)
+		}
+	}
+	if pkgPath == "" {
+		return sm, nil, errors.New("the package of the module is empty")
+	}
+	if pkgPath == s.modpath.RawPath || strings.HasPrefix(pkgPath, s.modpath.RawPath+"/") {
+		return sm, nil, fmt.Errorf("%s is a package of the app", pkgPath)
+	}
+
+	// The Go module of the package is required before analysing the module, a Go module already required without
+	// a version to import is kept as is instead of being upgraded
+	required, err := isRequired(s.path, pkgPath)
+	if err != nil {
+		return sm, nil, err
+	}
+
+	// go.mod and go.sum are restored unless the module is wired into the app
+	restore, err := backupFiles(s.path, "go.mod", "go.sum")
+	if err != nil {
+		return sm, nil, err
+	}
+	var wired bool
+	defer func() {
+		if wired {
+			return
+		}
+		if restoreErr := restore(); restoreErr != nil && err == nil {
+			err = restoreErr
+		}
+	}()
+
+	var goGetPackage string
+	if version != "" || !required {
+		goGetPackage = pkg
+		if version != "" {
+			goGetPackage = gocmd.PackageLiteral(pkgPath, version)
+		}
+		if err := gocmd.Get(ctx, s.path, []string{goGetPackage}); err != nil {
+			return sm, nil, err
+		}
+	}
+
+	m, err := wiring.Discover(ctx, s.path, path.Join(s.modpath.RawPath, appPkg), pkgPath)
+	if err != nil {
+		return sm, nil, err
+	}
+	name, err := multiformatname.NewName(m.Name)
+	if err != nil {
+		return sm, nil, fmt.Errorf("the name of the module %s can't be used in the app: %w", m.Name, err)
+	}
+	keeperName := name.UpperCamel + "Keeper"
+	if err := appanalysis.CheckKeeper(filepath.Join(s.path, module.PathAppModule), keeperName); err == nil {
+		return sm, nil, fmt.Errorf("the app already contains %s", keeperName)
+	}
+
+	opts := &moduleimport.ModuleOptions{
+		AppPath:      s.path,
+		ModuleName:   name,
+		Module:       m,
+		GoGetPackage: goGetPackage,
+	}
+	if !m.Resolved() {
+		return sm, moduleimport.Checklist(opts), nil
+	}
+
+	g, err := moduleimport.NewStargateModule(clip, opts)
+	if err != nil {
+		return sm, nil, err
+	}
+	sm, err = xgenny.RunWithValidation(clip, g)
+	if err != nil {
+		return sm, nil, err
+	}
+	wired = true
+
+	return sm, nil, finish(s.path, s.modpath.RawPath)
+}
+
+// backupFiles keeps the content of files of the app and returns the function restoring them, the files which don't
+// exist are removed by the restore
+func backupFiles(appPath string, names ...string) (restore func() error, err error) {
+	contents := make(map[string][]byte)
+	for _, name := range names {
+		content, err := os.ReadFile(filepath.Join(appPath, name))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		contents[name] = content
+	}
+
+	return func() error {
+		for name, content := range contents {
+			path := filepath.Join(appPath, name)
+			if content == nil {
+				if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				continue
+			}
+			if err := os.WriteFile(path, content, 0644); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
 // moduleExists checks if the module exists in the app
 func moduleExists(appPath string, moduleName string) (bool, error) {
 	absPath, err := filepath.Abs(filepath.Join(appPath, moduleDir, moduleName))
@@ -335,6 +525,20 @@ func checkModuleName(appPath, moduleName string) error {
 	return nil
 }
 
+// isRequired checks if a package is provided by a Go module required by the app
+func isRequired(appPath, pkgPath string) (bool, error) {
+	modFile, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range modFile.Require {
+		if pkgPath == r.Mod.Path || strings.HasPrefix(pkgPath, r.Mod.Path+"/") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func isWasmImported(appPath string) (bool, error) {
 	abspath := filepath.Join(appPath, appPkg)
 	fset := token.NewFileSet()
@@ -354,20 +558,6 @@ func isWasmImported(appPath string) (bool, error) {
 	return false, nil
 }
 
-func (s Scaffolder) installWasm() error {
-	switch {
-	case s.Version.GTE(cosmosver.StargateFortyVersion):
-		return cmdrunner.
-			New().
-			Run(context.Background(),
-				step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(wasmImport, wasmVersion))),
-				step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(extrasImport, extrasVersion))),
-			)
-	default:
-		return errors.New("version not supported")
-	}
-}
-
 // checkDependencies perform checks on the dependencies
 func checkDependencies(dependencies []modulecreate.Dependency, appPath string) error {
 	depMap := make(map[string]struct{})
@@ -6,30 +6,25 @@ import (
 
 	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/clipper"
-	"github.com/tendermint/starport/starport/pkg/cmdrunner"
-	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 	"github.com/tendermint/starport/starport/pkg/gocmd"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/templates/ibc"
 )
 
-const (
-	bandImport  = "github.com/bandprotocol/bandchain-packet"
-	bandVersion = "v0.0.2"
-)
-
 // OracleOption configures options for AddOracle.
 type OracleOption func(*oracleOptions)
 
 type oracleOptions struct {
-	signer string
+	signer   string
+	provider string
 }
 
 // newOracleOptions returns a oracleOptions with default options
 func newOracleOptions() oracleOptions {
 	return oracleOptions{
-		signer: "creator",
+		signer:   "creator",
+		provider: ibc.OracleProviderBand,
 	}
 }
 
@@ -40,22 +35,33 @@ func OracleWithSigner(signer string) OracleOption {
 	}
 }
 
-// AddOracle adds a new BandChain oracle envtest.
+// OracleWithProvider provides the name of the provider answering the oracle queries
+func OracleWithProvider(provider string) OracleOption {
+	return func(m *oracleOptions) {
+		m.provider = provider
+	}
+}
+
+// AddOracle adds a new oracle query answered by an oracle provider, BandChain by default.
 func (s *Scaffolder) AddOracle(
 	clip *clipper.Clipper,
 	moduleName,
 	queryName string,
 	options ...OracleOption,
 ) (sm xgenny.SourceModification, err error) {
-	if err := s.installBandPacket(); err != nil {
-		return sm, err
-	}
-
 	o := newOracleOptions()
 	for _, apply := range options {
 		apply(&o)
 	}
 
+	provider, err := ibc.GetOracleProvider(o.provider)
+	if err != nil {
+		return sm, err
+	}
+	if err := s.installOracleDependencies(provider); err != nil {
+		return sm, err
+	}
+
 	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
 	if err != nil {
 		return sm, err
@@ -96,6 +102,7 @@ func (s *Scaffolder) AddOracle(
 			OwnerName:  owner(s.modpath.RawPath),
 			QueryName:  name,
 			MsgSigner:  mfSigner,
+			Provider:   provider,
 		}
 	)
 	g, err = ibc.NewOracle(clip, opts)
@@ -109,9 +116,9 @@ func (s *Scaffolder) AddOracle(
 	return sm, finish(opts.AppPath, s.modpath.RawPath)
 }
 
-func (s Scaffolder) installBandPacket() error {
-	return cmdrunner.New().
-		Run(context.Background(),
-			step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(bandImport, bandVersion))),
-		)
+func (s Scaffolder) installOracleDependencies(provider ibc.OracleProvider) error {
+	if len(provider.Dependencies) == 0 {
+		return nil
+	}
+	return gocmd.Get(context.Background(), s.path, provider.Dependencies)
 }
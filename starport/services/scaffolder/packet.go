@@ -2,9 +2,11 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/clipper"
@@ -17,18 +19,25 @@ import (
 
 const (
 	ibcModuleImplementation = "module_ibc.go"
+
+	// DefaultPacketTimeoutDuration is the default timeout of a packet relative to the time of the counterparty chain
+	DefaultPacketTimeoutDuration = 10 * time.Minute
 )
 
 // packetOptions represents configuration for the packet scaffolding
 type packetOptions struct {
-	withoutMessage bool
-	signer         string
+	withoutMessage  bool
+	signer          string
+	timeoutHeight   uint64
+	timeoutDuration time.Duration
+	maxRetries      uint64
 }
 
 // newPacketOptions returns a packetOptions with default options
 func newPacketOptions() packetOptions {
 	return packetOptions{
-		signer: "creator",
+		signer:          "creator",
+		timeoutDuration: DefaultPacketTimeoutDuration,
 	}
 }
 
@@ -49,6 +58,30 @@ func PacketWithSigner(signer string) PacketOption {
 	}
 }
 
+// PacketWithTimeoutHeight sets the default timeout height of the packet relative to the counterparty chain,
+// the timeout height is disabled if it is 0
+func PacketWithTimeoutHeight(height uint64) PacketOption {
+	return func(m *packetOptions) {
+		m.timeoutHeight = height
+	}
+}
+
+// PacketWithTimeoutDuration sets the default timeout of the packet relative to the time of the counterparty chain,
+// the timeout duration is disabled if it is 0
+func PacketWithTimeoutDuration(duration time.Duration) PacketOption {
+	return func(m *packetOptions) {
+		m.timeoutDuration = duration
+	}
+}
+
+// PacketWithRetry re-sends the timed out packets until the maximum number of retries is reached.
+// The packets waiting for their acknowledgement are kept in a pending packet store of the module.
+func PacketWithRetry(maxRetries uint64) PacketOption {
+	return func(m *packetOptions) {
+		m.maxRetries = maxRetries
+	}
+}
+
 // AddPacket adds a new type stype to scaffolded app by using optional type fields.
 func (s Scaffolder) AddPacket(
 	ctx context.Context,
@@ -65,6 +98,10 @@ func (s Scaffolder) AddPacket(
 		apply(&o)
 	}
 
+	if err := checkPacketTimeout(o); err != nil {
+		return sm, err
+	}
+
 	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
 	if err != nil {
 		return sm, err
@@ -100,22 +137,22 @@ func (s Scaffolder) AddPacket(
 	}
 
 	// Check and parse packet fields
-	if err := checkCustomTypes(ctx, s.path, moduleName, packetFields); err != nil {
-		return sm, err
-	}
 	parsedPacketFields, err := field.ParseFields(packetFields, checkForbiddenPacketField, signer)
 	if err != nil {
 		return sm, err
 	}
-
-	// check and parse acknowledgment fields
-	if err := checkCustomTypes(ctx, s.path, moduleName, ackFields); err != nil {
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedPacketFields); err != nil {
 		return sm, err
 	}
+
+	// check and parse acknowledgment fields
 	parsedAcksFields, err := field.ParseFields(ackFields, checkGoReservedWord, signer)
 	if err != nil {
 		return sm, err
 	}
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedAcksFields); err != nil {
+		return sm, err
+	}
 
 	// Generate the packet
 	var (
@@ -131,17 +168,48 @@ func (s Scaffolder) AddPacket(
 			AckFields:  parsedAcksFields,
 			NoMessage:  o.withoutMessage,
 			MsgSigner:  mfSigner,
+
+			TimeoutHeight:   o.timeoutHeight,
+			TimeoutDuration: o.timeoutDuration,
+			MaxRetries:      o.maxRetries,
 		}
 	)
 	g, err = ibc.NewPacket(clip, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(clip, g)
+	gens, err := supportEnums(
+		ctx,
+		[]*genny.Generator{g},
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.AckFields,
+	)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(opts.AppPath, s.modpath.RawPath)
+	sm, err = s.run(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, s.finishOrDefer()
+}
+
+// checkPacketTimeout checks that the packet can time out and that the timed out packets can be re-sent
+func checkPacketTimeout(o packetOptions) error {
+	if o.timeoutDuration < 0 {
+		return fmt.Errorf("invalid packet timeout duration %s", o.timeoutDuration)
+	}
+	if o.timeoutHeight == 0 && o.timeoutDuration == 0 {
+		return errors.New("the packet must have a timeout height or a timeout duration")
+	}
+	if o.maxRetries > 0 && o.timeoutDuration == 0 {
+		return errors.New("the timed out packets can only be re-sent with a timeout duration")
+	}
+	return nil
 }
 
 // isIBCModule returns true if the provided module implements the IBC module interface
@@ -173,6 +241,8 @@ func checkForbiddenPacketField(name string) error {
 		"sender",
 		"port",
 		"channelid",
+		"timeoutheight",
+		"timeouttimestamp",
 		datatype.TypeCustom:
 		return fmt.Errorf("%s is used by the packet scaffolder", name)
 	}
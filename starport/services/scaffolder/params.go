@@ -0,0 +1,119 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field"
+	modulecreate "github.com/tendermint/starport/starport/templates/module/create"
+	"github.com/tendermint/starport/starport/templates/params"
+)
+
+// AddParams adds new params to a module of the app.
+// the params are added to the params subspace of the module, its genesis, its simulation and its tests.
+// the params subspace is created if the module doesn't define params yet.
+func (s Scaffolder) AddParams(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	moduleName string,
+	paramsList []string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the params to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	// The new params can't have the name of the existing ones
+	existingParams, err := moduleParamNames(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
+	// Parse params with the associated type
+	tParams, err := field.ParseFields(paramsList, checkForbiddenTypeIndex, existingParams...)
+	if err != nil {
+		return sm, err
+	}
+	if enums := tParams.Enums(); len(enums) > 0 {
+		return sm, fmt.Errorf("the param %s can't be an enum", enums[0].Name.Original)
+	}
+
+	opts := &params.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+		Params:     tParams,
+	}
+
+	// Check and support the params and the simulation of the module
+	gens, err := supportParams(
+		nil,
+		clip,
+		&modulecreate.ParamsOptions{
+			ModuleName: opts.ModuleName,
+			ModulePath: opts.ModulePath,
+			AppName:    opts.AppName,
+			AppPath:    opts.AppPath,
+			OwnerName:  owner(opts.ModulePath),
+		},
+	)
+	if err != nil {
+		return sm, err
+	}
+	gens, err = supportSimulation(
+		gens,
+		opts.AppPath,
+		opts.ModulePath,
+		opts.ModuleName,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := params.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
+
+// moduleParamNames returns the names of the params of a module, none if the module doesn't define params
+func moduleParamNames(appPath, moduleName string) ([]string, error) {
+	_, err := os.Stat(filepath.Join(appPath, moduleDir, moduleName, "types", "params.go"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	paramsName, err := multiformatname.NewName("params")
+	if err != nil {
+		return nil, err
+	}
+	return typeFieldNames(appPath, moduleName, paramsName)
+}
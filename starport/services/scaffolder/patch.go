@@ -6,7 +6,12 @@ import (
 
 	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/templates/ante"
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
 	modulecreate "github.com/tendermint/starport/starport/templates/module/create"
+	"github.com/tendermint/starport/starport/templates/params"
 )
 
 // supportSimulation checks if module_simulation.go exists
@@ -94,3 +99,202 @@ func isMsgServerDefined(appPath, moduleName string) (bool, error) {
 	}
 	return true, err
 }
+
+// supportEvents checks if proto/<module>/events.proto exists
+// appends the generator to create the file if it doesn't
+func supportEvents(
+	gens []*genny.Generator,
+	appPath,
+	appName,
+	modulePath,
+	moduleName string,
+) ([]*genny.Generator, error) {
+	events, err := modulecreate.AddEvents(
+		appPath,
+		appName,
+		modulePath,
+		moduleName,
+		owner(modulePath),
+	)
+	if err != nil {
+		return gens, err
+	}
+	gens = append(gens, events)
+	return gens, nil
+}
+
+// supportProposals checks if proto/<module>/proposal.proto and the proposal handler of the module exist
+// appends the generator to create them if they don't
+func supportProposals(
+	gens []*genny.Generator,
+	appPath,
+	appName,
+	modulePath,
+	moduleName string,
+) ([]*genny.Generator, error) {
+	proposals, err := modulecreate.AddProposals(
+		appPath,
+		appName,
+		modulePath,
+		moduleName,
+		owner(modulePath),
+	)
+	if err != nil {
+		return gens, err
+	}
+	gens = append(gens, proposals)
+	return gens, nil
+}
+
+// supportMigrator appends the generator creating the migrator handling the store migrations of the module
+// keeper/migrations.go is only created if the file doesn't exist yet
+func supportMigrator(
+	gens []*genny.Generator,
+	appPath,
+	modulePath,
+	moduleName string,
+) ([]*genny.Generator, error) {
+	migrator, err := modulecreate.AddMigrator(
+		appPath,
+		modulePath,
+		moduleName,
+	)
+	if err != nil {
+		return gens, err
+	}
+	gens = append(gens, migrator)
+	return gens, nil
+}
+
+// supportKeeperExport appends the generator creating keeper/export_test.go exposing the internals of the keeper
+// to its tests, the file is only created if it doesn't exist yet
+func supportKeeperExport(
+	gens []*genny.Generator,
+	appPath,
+	moduleName string,
+) ([]*genny.Generator, error) {
+	export, err := modulecreate.AddKeeperExport(
+		appPath,
+		moduleName,
+	)
+	if err != nil {
+		return gens, err
+	}
+	gens = append(gens, export)
+	return gens, nil
+}
+
+// supportAnteHandler appends the generator creating app/ante/handler.go chaining the ante decorators of the app
+// the file is only created if it doesn't exist yet and app.go is left as is if it already uses this ante handler
+func supportAnteHandler(
+	gens []*genny.Generator,
+	clip *clipper.Clipper,
+	appPath,
+	modulePath string,
+) ([]*genny.Generator, error) {
+	handler, err := ante.AddHandler(
+		clip,
+		appPath,
+		modulePath,
+	)
+	if err != nil {
+		return gens, err
+	}
+	gens = append(gens, handler)
+	return gens, nil
+}
+
+// supportParams checks if the module defines params and if the params files exist
+// appends the generator to create the missing files and to add the params subspace to the module if it doesn't
+func supportParams(
+	gens []*genny.Generator,
+	clip *clipper.Clipper,
+	opts *modulecreate.ParamsOptions,
+) ([]*genny.Generator, error) {
+	params, err := modulecreate.AddParams(clip, opts)
+	if err != nil {
+		return gens, err
+	}
+	gens = append(gens, params)
+	return gens, nil
+}
+
+// supportAdmins appends the generators adding the admins param to the module if it isn't defined yet
+// and creating the keeper method checking the admins if its file doesn't exist yet
+// the admins are set in the params of the module and can be seeded in the genesis of the chain
+func supportAdmins(
+	gens []*genny.Generator,
+	clip *clipper.Clipper,
+	appPath,
+	appName,
+	modulePath,
+	moduleName string,
+) ([]*genny.Generator, error) {
+	adminsName, err := multiformatname.NewName("admins")
+	if err != nil {
+		return gens, err
+	}
+	existingParams, err := moduleParamNames(appPath, moduleName)
+	if err != nil {
+		return gens, err
+	}
+
+	var defined bool
+	for _, name := range existingParams {
+		if name == adminsName.LowerCamel {
+			defined = true
+		}
+	}
+	if !defined {
+		gens, err = supportParams(
+			gens,
+			clip,
+			&modulecreate.ParamsOptions{
+				ModuleName: moduleName,
+				ModulePath: modulePath,
+				AppName:    appName,
+				AppPath:    appPath,
+				OwnerName:  owner(modulePath),
+			},
+		)
+		if err != nil {
+			return gens, err
+		}
+
+		g, err := params.NewStargate(clip, &params.Options{
+			AppName:    appName,
+			AppPath:    appPath,
+			ModuleName: moduleName,
+			ModulePath: modulePath,
+			Params: field.Fields{{
+				Name:         adminsName,
+				DatatypeName: datatype.StringSlice,
+			}},
+			Overrides: map[string]params.Override{
+				adminsName.LowerCamel: {
+					Default: "nil",
+					Validation: `for _, admin := range admins {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return fmt.Errorf("invalid admin address %s: %w", admin, err)
+		}
+	}`,
+					GoImports: []datatype.GoImport{{
+						Alias: "sdk",
+						Name:  "github.com/cosmos/cosmos-sdk/types",
+					}},
+				},
+			},
+		})
+		if err != nil {
+			return gens, err
+		}
+		gens = append(gens, g)
+	}
+
+	admins, err := modulecreate.AddAdmins(appPath, modulePath, moduleName)
+	if err != nil {
+		return gens, err
+	}
+	gens = append(gens, admins)
+	return gens, nil
+}
@@ -0,0 +1,115 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/proposal"
+)
+
+// AddProposal adds a new governance proposal to scaffolded app
+func (s Scaffolder) AddProposal(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	moduleName,
+	proposalName string,
+	fields []string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the type to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(proposalName)
+	if err != nil {
+		return sm, err
+	}
+
+	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
+		return sm, err
+	}
+
+	// Check and parse provided fields
+	parsedFields, err := field.ParseFields(fields, checkForbiddenProposalField)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedFields); err != nil {
+		return sm, err
+	}
+
+	var (
+		g    *genny.Generator
+		opts = &proposal.Options{
+			AppName:      s.modpath.Package,
+			AppPath:      s.path,
+			ModulePath:   s.modpath.RawPath,
+			ModuleName:   moduleName,
+			OwnerName:    owner(s.modpath.RawPath),
+			ProposalName: name,
+			Fields:       parsedFields,
+		}
+	)
+
+	gens, err := supportProposals(
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	// Scaffold
+	g, err = proposal.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+	gens, err = supportEnums(
+		ctx,
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+	)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(clip, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
+
+// checkForbiddenProposalField returns true if the name is forbidden as a proposal field name
+func checkForbiddenProposalField(name string) error {
+	mfName, err := multiformatname.NewName(name)
+	if err != nil {
+		return err
+	}
+
+	switch mfName.LowerCase {
+	case
+		"title",
+		"description":
+		return fmt.Errorf("%s is used by the proposal scaffolder", name)
+	}
+
+	return checkGoReservedWord(name)
+}
@@ -42,22 +42,22 @@ func (s Scaffolder) AddQuery(
 	}
 
 	// Check and parse provided request fields
-	if err := checkCustomTypes(ctx, s.path, moduleName, reqFields); err != nil {
-		return sm, err
-	}
 	parsedReqFields, err := field.ParseFields(reqFields, checkGoReservedWord)
 	if err != nil {
 		return sm, err
 	}
-
-	// Check and parse provided response fields
-	if err := checkCustomTypes(ctx, s.path, moduleName, resFields); err != nil {
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedReqFields); err != nil {
 		return sm, err
 	}
+
+	// Check and parse provided response fields
 	parsedResFields, err := field.ParseFields(resFields, checkGoReservedWord)
 	if err != nil {
 		return sm, err
 	}
+	if err := checkCustomTypes(ctx, s.path, moduleName, parsedResFields); err != nil {
+		return sm, err
+	}
 
 	var (
 		g    *genny.Generator
@@ -80,9 +80,22 @@ func (s Scaffolder) AddQuery(
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(clip, g)
+	gens, err := supportEnums(
+		ctx,
+		[]*genny.Generator{g},
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.ReqFields,
+		opts.ResFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = s.run(clip, gens...)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(opts.AppPath, s.modpath.RawPath)
+	return sm, s.finishOrDefer()
 }
@@ -0,0 +1,163 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/remove"
+)
+
+// RemoveComponent removes a type, message, query, packet or event scaffolded in a module of the app.
+// the files created for the component are deleted and the code added to existing files is removed.
+// if no module is given, the component is removed from the app's default module.
+func (s Scaffolder) RemoveComponent(
+	clip *clipper.Clipper,
+	moduleName,
+	componentName string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we remove the component from the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(componentName)
+	if err != nil {
+		return sm, err
+	}
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	kind, noMessage, err := componentKind(s.path, moduleName, name)
+	if err != nil {
+		return sm, err
+	}
+
+	var listedBy []multiformatname.Name
+	if kind == remove.KindList || kind == remove.KindMap {
+		listedBy, err = typeListedBy(s.path, moduleName, name)
+		if err != nil {
+			return sm, err
+		}
+	}
+
+	opts := &remove.Options{
+		AppPath:       s.path,
+		ModuleName:    moduleName,
+		ComponentName: name,
+		Kind:          kind,
+		NoMessage:     noMessage,
+		ListedBy:      listedBy,
+	}
+	removedFiles := remove.Files(opts)
+
+	g, err := remove.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(clip, g)
+	if err != nil {
+		return sm, err
+	}
+	sm.AppendRemovedFiles(removedFiles...)
+
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
+
+// componentKind finds the kind of the component scaffolded with the name in the module
+// noMessage is true if the component is a type scaffolded without CRUD messages
+func componentKind(appPath, moduleName string, compName multiformatname.Name) (
+	kind remove.Kind,
+	noMessage bool,
+	err error,
+) {
+	structTypes, err := moduleStructTypes(appPath, moduleName)
+	if err != nil {
+		return kind, noMessage, err
+	}
+	exists := func(name string) bool {
+		_, ok := structTypes[name]
+		return ok
+	}
+
+	name := compName.UpperCamel
+	switch {
+	case exists(name + "PacketData"):
+		return remove.KindPacket, false, nil
+	case exists("Query" + name + "Request"):
+		return remove.KindQuery, false, nil
+	case exists(name):
+		noMessage = !exists("MsgCreate" + name)
+
+		switch {
+		case exists("QueryAll" + name + "Request"):
+			// A map type defines a key file for its indexes
+			keyFile := filepath.Join(appPath, "x", moduleName, "types", "key_"+compName.Snake+".go")
+			if _, err := os.Stat(keyFile); err == nil {
+				return remove.KindMap, noMessage, nil
+			}
+			return remove.KindList, noMessage, nil
+		case exists("QueryGet" + name + "Request"):
+			return remove.KindSingleton, noMessage, nil
+		}
+		return remove.KindDry, true, nil
+	case exists("Msg" + name):
+		return remove.KindMessage, false, nil
+	case exists("Event" + name):
+		return remove.KindEvent, false, nil
+	case exists(name + "Proposal"):
+		return remove.KindProposal, false, nil
+	}
+
+	return kind, noMessage, fmt.Errorf("no component with name %s found in the module %s", compName.Original, moduleName)
+}
+
+// typeListedBy returns the names of the secondary indexes or index prefixes a list or a map can be listed by
+// they are found from the requests of the queries listing the values by each of them
+func typeListedBy(appPath, moduleName string, typeName multiformatname.Name) ([]multiformatname.Name, error) {
+	structTypes, err := moduleStructTypes(appPath, moduleName)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		prefix = "QueryList" + typeName.UpperCamel + "By"
+		suffix = "Request"
+		names  []string
+	)
+	for structName := range structTypes {
+		if !strings.HasPrefix(structName, prefix) || !strings.HasSuffix(structName, suffix) {
+			continue
+		}
+		if name := strings.TrimSuffix(strings.TrimPrefix(structName, prefix), suffix); name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var indexes []multiformatname.Name
+	for _, name := range names {
+		index, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
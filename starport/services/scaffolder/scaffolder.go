@@ -8,8 +8,10 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/chainconfig"
 	sperrors "github.com/tendermint/starport/starport/errors"
+	"github.com/tendermint/starport/starport/pkg/clipper"
 	"github.com/tendermint/starport/starport/pkg/cmdrunner"
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
@@ -20,6 +22,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/gocmd"
 	"github.com/tendermint/starport/starport/pkg/gomodule"
 	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
 )
 
 // Scaffolder is Starport app scaffolder.
@@ -32,6 +35,10 @@ type Scaffolder struct {
 
 	// Version of the chain
 	Version cosmosver.Version
+
+	// runner runs the generators of a batch of components, the generation of the code from the proto files, the
+	// tidying and the formatting of the app are run once by the caller scaffolding the batch.
+	runner *xgenny.Runner
 }
 
 // App creates a new scaffolder for an existent app.
@@ -75,6 +82,22 @@ func owner(modulePath string) string {
 	return strings.Split(modulePath, "/")[1]
 }
 
+// run checks and runs the generators of a component, the generators of a batch are run by the runner of the batch.
+func (s Scaffolder) run(clip *clipper.Clipper, gens ...*genny.Generator) (xgenny.SourceModification, error) {
+	if s.runner != nil {
+		return s.runner.Run(gens...)
+	}
+	return xgenny.RunWithValidation(clip, gens...)
+}
+
+// finishOrDefer finishes the scaffolding of a component unless the finish is deferred to the end of a batch.
+func (s Scaffolder) finishOrDefer() error {
+	if s.runner != nil {
+		return nil
+	}
+	return finish(s.path, s.modpath.RawPath)
+}
+
 func finish(path, gomodPath string) error {
 	if err := protoc(path, gomodPath); err != nil {
 		return err
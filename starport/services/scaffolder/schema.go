@@ -0,0 +1,163 @@
+package scaffolder
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/goccy/go-yaml"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// Kinds of the types declared in a schema.
+const (
+	SchemaKindList      = "list"
+	SchemaKindMap       = "map"
+	SchemaKindSingleton = "single"
+	SchemaKindType      = "type"
+)
+
+// Schema declares the modules of an app and the components scaffolded in them.
+type Schema struct {
+	Modules []SchemaModule `yaml:"modules"`
+}
+
+// SchemaModule declares a module and its components.
+// the IBC and dependencies options of an existing module can't be changed.
+type SchemaModule struct {
+	Name         string          `yaml:"name"`
+	IBC          bool            `yaml:"ibc"`
+	Dependencies []string        `yaml:"dependencies"`
+	Types        []SchemaType    `yaml:"types"`
+	Messages     []SchemaMessage `yaml:"messages"`
+	Queries      []SchemaQuery   `yaml:"queries"`
+	Packets      []SchemaPacket  `yaml:"packets"`
+}
+
+// SchemaType declares a list, a map, a singleton or a type without storage.
+type SchemaType struct {
+	Name      string   `yaml:"name"`
+	Kind      string   `yaml:"kind"`
+	Fields    []string `yaml:"fields"`
+	Indexes   []string `yaml:"indexes"`
+	NoMessage bool     `yaml:"no-message"`
+	Signer    string   `yaml:"signer"`
+}
+
+// SchemaMessage declares a message.
+type SchemaMessage struct {
+	Name        string   `yaml:"name"`
+	Fields      []string `yaml:"fields"`
+	Response    []string `yaml:"response"`
+	Description string   `yaml:"desc"`
+	Signer      string   `yaml:"signer"`
+}
+
+// SchemaQuery declares a query.
+type SchemaQuery struct {
+	Name        string   `yaml:"name"`
+	Request     []string `yaml:"request"`
+	Response    []string `yaml:"response"`
+	Description string   `yaml:"desc"`
+	Paginated   bool     `yaml:"paginated"`
+}
+
+// SchemaPacket declares an IBC packet.
+type SchemaPacket struct {
+	Name      string   `yaml:"name"`
+	Fields    []string `yaml:"fields"`
+	Ack       []string `yaml:"ack"`
+	NoMessage bool     `yaml:"no-message"`
+	Signer    string   `yaml:"signer"`
+}
+
+// ParseSchema parses a schema and validates it.
+func ParseSchema(r io.Reader) (Schema, error) {
+	var schema Schema
+	if err := yaml.NewDecoder(r).Decode(&schema); err != nil {
+		return schema, err
+	}
+	return schema, schema.Validate()
+}
+
+// ParseSchemaFile parses the schema file at path.
+func ParseSchemaFile(path string) (Schema, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Schema{}, err
+	}
+	defer file.Close()
+	return ParseSchema(file)
+}
+
+// Validate checks the modules are declared once, the types have a valid kind and the components of a module
+// have distinct names.
+func (s Schema) Validate() error {
+	modules := make(map[string]struct{})
+	for _, module := range s.Modules {
+		if module.Name == "" {
+			return errors.New("a module of the schema has no name")
+		}
+		mfName, err := multiformatname.NewName(module.Name, multiformatname.NoNumber)
+		if err != nil {
+			return err
+		}
+		if _, ok := modules[mfName.LowerCase]; ok {
+			return fmt.Errorf("the module %s is declared twice", module.Name)
+		}
+		modules[mfName.LowerCase] = struct{}{}
+
+		components := make(map[string]struct{})
+		addComponent := func(name string) error {
+			mfName, err := multiformatname.NewName(name)
+			if err != nil {
+				return fmt.Errorf("invalid component name in the module %s: %w", module.Name, err)
+			}
+			if _, ok := components[mfName.UpperCamel]; ok {
+				return fmt.Errorf("the component %s is declared twice in the module %s", name, module.Name)
+			}
+			components[mfName.UpperCamel] = struct{}{}
+			return nil
+		}
+
+		for _, t := range module.Types {
+			if err := addComponent(t.Name); err != nil {
+				return err
+			}
+			switch t.Kind {
+			case SchemaKindList, SchemaKindSingleton, SchemaKindType:
+				if len(t.Indexes) > 0 {
+					return fmt.Errorf("the type %s is a %s, only a map can have indexes", t.Name, t.Kind)
+				}
+			case SchemaKindMap:
+			default:
+				return fmt.Errorf(
+					"invalid kind %s for the type %s, the kind must be %s, %s, %s or %s",
+					t.Kind,
+					t.Name,
+					SchemaKindList,
+					SchemaKindMap,
+					SchemaKindSingleton,
+					SchemaKindType,
+				)
+			}
+		}
+		for _, m := range module.Messages {
+			if err := addComponent(m.Name); err != nil {
+				return err
+			}
+		}
+		for _, q := range module.Queries {
+			if err := addComponent(q.Name); err != nil {
+				return err
+			}
+		}
+		for _, p := range module.Packets {
+			if err := addComponent(p.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
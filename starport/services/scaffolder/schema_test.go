@@ -0,0 +1,175 @@
+package scaffolder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const schemaYAML = `modules:
+  - name: blog
+    ibc: true
+    dependencies:
+      - bank
+      - account:AccountKeeper
+    types:
+      - name: post
+        kind: list
+        fields:
+          - title
+          - body
+      - name: author
+        kind: map
+        fields:
+          - name
+        indexes:
+          - address
+        signer: owner
+      - name: settings
+        kind: single
+        no-message: true
+    messages:
+      - name: like-post
+        fields:
+          - postID:uint
+        response:
+          - likes:uint
+        desc: like a post
+    queries:
+      - name: posts-by-author
+        request:
+          - author
+        response:
+          - ids:array.uint
+        paginated: true
+    packets:
+      - name: share-post
+        fields:
+          - postID:uint
+        ack:
+          - postID:uint
+`
+
+func TestParseSchema(t *testing.T) {
+	schema, err := ParseSchema(strings.NewReader(schemaYAML))
+	require.NoError(t, err)
+	require.Equal(t, Schema{
+		Modules: []SchemaModule{{
+			Name:         "blog",
+			IBC:          true,
+			Dependencies: []string{"bank", "account:AccountKeeper"},
+			Types: []SchemaType{
+				{Name: "post", Kind: SchemaKindList, Fields: []string{"title", "body"}},
+				{
+					Name:    "author",
+					Kind:    SchemaKindMap,
+					Fields:  []string{"name"},
+					Indexes: []string{"address"},
+					Signer:  "owner",
+				},
+				{Name: "settings", Kind: SchemaKindSingleton, NoMessage: true},
+			},
+			Messages: []SchemaMessage{{
+				Name:        "like-post",
+				Fields:      []string{"postID:uint"},
+				Response:    []string{"likes:uint"},
+				Description: "like a post",
+			}},
+			Queries: []SchemaQuery{{
+				Name:      "posts-by-author",
+				Request:   []string{"author"},
+				Response:  []string{"ids:array.uint"},
+				Paginated: true,
+			}},
+			Packets: []SchemaPacket{{
+				Name:   "share-post",
+				Fields: []string{"postID:uint"},
+				Ack:    []string{"postID:uint"},
+			}},
+		}},
+	}, schema)
+
+	_, err = ParseSchema(strings.NewReader("modules:\n  - name: [blog\n"))
+	require.Error(t, err)
+
+	_, err = ParseSchema(strings.NewReader("modules:\n  - name: blog\n    types:\n      - name: post\n        kind: set\n"))
+	require.EqualError(t, err, "invalid kind set for the type post, the kind must be list, map, single or type")
+}
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema Schema
+		err    string
+	}{
+		{
+			name: "valid schema",
+			schema: Schema{Modules: []SchemaModule{
+				{
+					Name: "blog",
+					Types: []SchemaType{
+						{Name: "post", Kind: SchemaKindList},
+						{Name: "author", Kind: SchemaKindMap, Indexes: []string{"address"}},
+					},
+					Messages: []SchemaMessage{{Name: "like-post"}},
+				},
+				{Name: "forum", Types: []SchemaType{{Name: "post", Kind: SchemaKindType}}},
+			}},
+		},
+		{
+			name:   "module without name",
+			schema: Schema{Modules: []SchemaModule{{}}},
+			err:    "a module of the schema has no name",
+		},
+		{
+			name:   "duplicated module",
+			schema: Schema{Modules: []SchemaModule{{Name: "blog"}, {Name: "Blog"}}},
+			err:    "the module Blog is declared twice",
+		},
+		{
+			name: "invalid kind",
+			schema: Schema{Modules: []SchemaModule{{
+				Name:  "blog",
+				Types: []SchemaType{{Name: "post"}},
+			}}},
+			err: "invalid kind  for the type post, the kind must be list, map, single or type",
+		},
+		{
+			name: "indexes of a list",
+			schema: Schema{Modules: []SchemaModule{{
+				Name:  "blog",
+				Types: []SchemaType{{Name: "post", Kind: SchemaKindList, Indexes: []string{"id"}}},
+			}}},
+			err: "the type post is a list, only a map can have indexes",
+		},
+		{
+			name: "duplicated component",
+			schema: Schema{Modules: []SchemaModule{{
+				Name:     "blog",
+				Types:    []SchemaType{{Name: "post", Kind: SchemaKindList}},
+				Messages: []SchemaMessage{{Name: "Post"}},
+			}}},
+			err: "the component Post is declared twice in the module blog",
+		},
+		{
+			name: "invalid component name",
+			schema: Schema{Modules: []SchemaModule{{
+				Name:    "blog",
+				Queries: []SchemaQuery{{Name: "1post"}},
+			}}},
+			err: "invalid component name in the module blog",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -2,12 +2,16 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/clipper"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/templates/field"
 	"github.com/tendermint/starport/starport/templates/field/datatype"
@@ -16,6 +20,7 @@ import (
 	"github.com/tendermint/starport/starport/templates/typed/dry"
 	"github.com/tendermint/starport/starport/templates/typed/list"
 	maptype "github.com/tendermint/starport/starport/templates/typed/map"
+	"github.com/tendermint/starport/starport/templates/typed/ownership"
 	"github.com/tendermint/starport/starport/templates/typed/singleton"
 )
 
@@ -33,10 +38,16 @@ type addTypeOptions struct {
 	isMap       bool
 	isSingleton bool
 
-	indexes []string
+	indexes          []string
+	secondaryIndexes []string
+	invariants       bool
 
 	withoutMessage bool
 	signer         string
+	access         string
+
+	protoFile    string
+	protoMessage string
 }
 
 // newAddTypeOptions returns a addTypeOptions with default options
@@ -103,6 +114,40 @@ func TypeWithSigner(signer string) AddTypeOption {
 	}
 }
 
+// TypeWithSecondaryIndexes indexes the values of a list or a map by the fields to query them by each of the fields.
+func TypeWithSecondaryIndexes(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.secondaryIndexes = fields
+	}
+}
+
+// TypeWithInvariants makes the module of a list or a map register invariants checking the consistency of the store
+// of the type.
+func TypeWithInvariants() AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.invariants = true
+	}
+}
+
+// TypeWithAccess sets the access control of the messages updating and deleting the values of a list, a map or a singleton
+// the owner access only authorizes the owner of a value, the admin access also authorizes the admins of the module
+// and the open access authorizes any account. The owner and admin accesses generate the message transferring the
+// ownership of the values, without access only the owner of a value is authorized and no message is generated.
+func TypeWithAccess(access string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.access = access
+	}
+}
+
+// TypeFromProto scaffolds a list or a map around an existing proto message of the module instead of generating it,
+// the fields of the type are read from the message.
+func TypeFromProto(protoFile, message string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.protoFile = protoFile
+		o.protoMessage = message
+	}
+}
+
 // AddType adds a new type to a scaffolded app.
 // if non of the list, map or singleton given, a dry type without anything extra (like a storage layer, models, CLI etc.)
 // will be scaffolded.
@@ -131,29 +176,78 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
-	if err := checkComponentValidity(s.path, moduleName, name, o.withoutMessage); err != nil {
+	// The proto message a type is scaffolded from already defines the type
+	var existingTypes []string
+	if o.protoFile != "" {
+		existingTypes = append(existingTypes, name.UpperCamel)
+	}
+	if err := checkComponentValidity(s.path, moduleName, name, o.withoutMessage, existingTypes...); err != nil {
 		return sm, err
 	}
 
+	if len(o.secondaryIndexes) > 0 && !o.isList && !o.isMap {
+		return sm, errors.New("secondary indexes can only be added to a list or a map")
+	}
+	if o.invariants && !o.isList && !o.isMap {
+		return sm, errors.New("invariants can only be added to a list or a map")
+	}
+	switch o.access {
+	case "", typed.AccessOwner, typed.AccessAdmin, typed.AccessOpen:
+	default:
+		return sm, fmt.Errorf(
+			"invalid access %s, the access must be %s, %s or %s",
+			o.access,
+			typed.AccessOwner,
+			typed.AccessAdmin,
+			typed.AccessOpen,
+		)
+	}
+	hasMessages := !o.withoutMessage && (o.isList || o.isMap || o.isSingleton)
+	if o.access != "" && !hasMessages {
+		return sm, errors.New("the access can only be set for a list, a map or a singleton with messages")
+	}
+
 	signer := ""
 	if !o.withoutMessage {
 		signer = o.signer
 	}
 
-	// Check and parse provided fields
-	if err := checkCustomTypes(ctx, s.path, moduleName, o.fields); err != nil {
-		return sm, err
+	var protoImport string
+	if o.protoFile != "" {
+		if !o.isList && !o.isMap {
+			return sm, errors.New("only a list or a map can be scaffolded from a proto message")
+		}
+		if len(o.fields) > 0 {
+			return sm, errors.New("the fields of a type scaffolded from a proto message are read from the message")
+		}
+		if o.protoMessage != name.UpperCamel {
+			return sm, fmt.Errorf("the type %s must be named after the proto message %s", name.Original, o.protoMessage)
+		}
+		protoImport, o.fields, o.indexes, err = typeFieldsFromProto(ctx, s.path, moduleName, o)
+		if err != nil {
+			return sm, err
+		}
 	}
+
+	// Check and parse provided fields
 	tFields, err := field.ParseFields(o.fields, checkForbiddenTypeField, signer)
 	if err != nil {
 		return sm, err
 	}
+	if err := checkCustomTypes(ctx, s.path, moduleName, tFields); err != nil {
+		return sm, err
+	}
 
 	mfSigner, err := multiformatname.NewName(o.signer)
 	if err != nil {
 		return sm, err
 	}
 
+	secondaryIndexes, err := parseSecondaryIndexes(o.secondaryIndexes, tFields, signer)
+	if err != nil {
+		return sm, err
+	}
+
 	isIBC, err := isIBCModule(s.path, moduleName)
 	if err != nil {
 		return sm, err
@@ -172,6 +266,11 @@ func (s Scaffolder) AddType(
 			NoMessage:  o.withoutMessage,
 			MsgSigner:  mfSigner,
 			IsIBC:      isIBC,
+
+			SecondaryIndexes: secondaryIndexes,
+			Invariants:       o.invariants,
+			Access:           o.access,
+			ProtoFile:        protoImport,
 		}
 		gens []*genny.Generator
 	)
@@ -213,6 +312,32 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	// the generated messages emit typed events
+	if hasMessages {
+		gens, err = supportEvents(
+			gens,
+			opts.AppPath,
+			opts.AppName,
+			opts.ModulePath,
+			opts.ModuleName,
+		)
+		if err != nil {
+			return sm, err
+		}
+	}
+
+	// the invariant tests corrupt the store of the keeper
+	if o.invariants {
+		gens, err = supportKeeperExport(
+			gens,
+			opts.AppPath,
+			opts.ModuleName,
+		)
+		if err != nil {
+			return sm, err
+		}
+	}
+
 	// create the type generator depending on the model
 	switch {
 	case o.isList:
@@ -230,12 +355,48 @@ func (s Scaffolder) AddType(
 
 	// run the generation
 	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(clip, gens...)
+
+	// the owner of a value can transfer its ownership, the admins of the module can also manage the values
+	if hasMessages && (o.access == typed.AccessOwner || o.access == typed.AccessAdmin) {
+		g, err = ownershipGenerator(clip, opts, o.isList)
+		if err != nil {
+			return sm, err
+		}
+		gens = append(gens, g)
+	}
+	if hasMessages && o.access == typed.AccessAdmin {
+		gens, err = supportAdmins(
+			gens,
+			clip,
+			opts.AppPath,
+			opts.AppName,
+			opts.ModulePath,
+			opts.ModuleName,
+		)
+		if err != nil {
+			return sm, err
+		}
+	}
+
+	gens, err = supportEnums(
+		ctx,
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.Indexes,
+	)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = s.run(clip, gens...)
 	if err != nil {
 		return sm, err
 	}
 
-	return sm, finish(opts.AppPath, s.modpath.RawPath)
+	return sm, s.finishOrDefer()
 }
 
 // checkForbiddenTypeIndex returns true if the name is forbidden as a field name
@@ -244,6 +405,9 @@ func checkForbiddenTypeIndex(name string) error {
 	if len(fieldSplit) > 1 {
 		name = fieldSplit[0]
 		fieldType := datatype.Name(fieldSplit[1])
+		if datatype.IsEnum(fieldType) {
+			return checkForbiddenTypeField(name)
+		}
 		if f, ok := datatype.SupportedTypes[fieldType]; !ok || f.NonIndex {
 			return fmt.Errorf("invalid index type %s", fieldType)
 		}
@@ -292,3 +456,209 @@ func mapGenerator(clip *clipper.Clipper, opts *typed.Options, indexes []string)
 	opts.Indexes = parsedIndexes
 	return maptype.NewStargate(clip, opts)
 }
+
+// ownershipGenerator returns the template generator for the message transferring the ownership of the values
+// the values of a list are identified by their id, the values of a map by their indexes and a singleton by nothing
+func ownershipGenerator(clip *clipper.Clipper, opts *typed.Options, isList bool) (*genny.Generator, error) {
+	keys := opts.Indexes
+	if isList {
+		id, err := multiformatname.NewName("id")
+		if err != nil {
+			return nil, err
+		}
+		keys = field.Fields{{Name: id, DatatypeName: datatype.Uint}}
+	}
+	return ownership.NewStargate(clip, opts, keys)
+}
+
+// parseSecondaryIndexes returns the fields of the type referenced by the secondary indexes
+// a secondary index references a field of the type or the signer of its messages
+func parseSecondaryIndexes(names []string, fields field.Fields, signer string) (field.Fields, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	typeFields := make(map[string]field.Field)
+	for _, f := range fields {
+		typeFields[f.Name.LowerCamel] = f
+	}
+	if signer != "" {
+		mfSigner, err := multiformatname.NewName(signer)
+		if err != nil {
+			return nil, err
+		}
+		typeFields[mfSigner.LowerCamel] = field.Field{
+			Name:         mfSigner,
+			DatatypeName: datatype.String,
+		}
+	}
+
+	var (
+		indexes field.Fields
+		indexed = make(map[string]struct{})
+	)
+	for _, name := range names {
+		mfName, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := typeFields[mfName.LowerCamel]
+		if !ok {
+			return nil, fmt.Errorf("the secondary index %s is not a field of the type", name)
+		}
+		if _, ok := indexed[mfName.LowerCamel]; ok {
+			return nil, fmt.Errorf("the secondary index %s is duplicated", name)
+		}
+		if dt, ok := datatype.SupportedTypes[f.DatatypeName]; !ok || dt.NonIndex {
+			return nil, fmt.Errorf("the field %s of type %s can't be used as a secondary index", name, f.DatatypeName)
+		}
+		indexed[mfName.LowerCamel] = struct{}{}
+		indexes = append(indexes, f)
+	}
+	return indexes, nil
+}
+
+// typeFieldsFromProto reads the proto message a list or a map is scaffolded from, it returns the import path of the
+// proto file, the fields of the type and the indexes of the map with the scaffolder types of the message fields
+// the id of a list, the indexes of a map and the signer of the messages are fields of the message but not of the type
+func typeFieldsFromProto(
+	ctx context.Context,
+	appPath,
+	moduleName string,
+	o addTypeOptions,
+) (protoImport string, fields, indexes []string, err error) {
+	protoDir := filepath.Join(appPath, protoFolder, moduleName)
+	protoFile := filepath.Clean(o.protoFile)
+	if !filepath.IsAbs(protoFile) {
+		protoFile = filepath.Join(appPath, protoFile)
+	}
+
+	// The message must be generated in the types package of the module
+	if filepath.Dir(protoFile) != protoDir {
+		return "", nil, nil, fmt.Errorf(
+			"the proto file %s must be in the proto package of the module %s",
+			o.protoFile,
+			filepath.Join(protoFolder, moduleName),
+		)
+	}
+	if _, err := os.Stat(protoFile); err != nil {
+		return "", nil, nil, err
+	}
+
+	messageFields, err := protoanalysis.MessageFields(ctx, protoFile, o.protoMessage)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var signer string
+	if !o.withoutMessage {
+		mfSigner, err := multiformatname.NewName(o.signer)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		signer = mfSigner.LowerCamel
+	}
+	indexTypes := make(map[string]string)
+	for _, index := range o.indexes {
+		name, err := multiformatname.NewName(strings.Split(index, datatype.Separator)[0])
+		if err != nil {
+			return "", nil, nil, err
+		}
+		indexTypes[name.LowerCamel] = ""
+	}
+
+	found := make(map[string]struct{})
+	for _, messageField := range messageFields {
+		name, err := multiformatname.NewName(messageField.Name)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		typeName, err := protoFieldTypeName(ctx, protoDir, o.protoMessage, messageField)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		found[name.LowerCamel] = struct{}{}
+
+		_, isIndex := indexTypes[name.LowerCamel]
+		switch {
+		case o.isList && name.LowerCamel == "id":
+			if typeName != string(datatype.Uint) {
+				return "", nil, nil, fmt.Errorf("the id of the proto message %s must be a uint64", o.protoMessage)
+			}
+		case name.LowerCamel == signer:
+			if typeName != string(datatype.String) {
+				return "", nil, nil, fmt.Errorf("the signer %s of the proto message %s must be a string", signer, o.protoMessage)
+			}
+		case isIndex:
+			indexTypes[name.LowerCamel] = typeName
+		default:
+			fields = append(fields, name.LowerCamel+datatype.Separator+typeName)
+		}
+	}
+
+	if _, ok := found["id"]; o.isList && !ok {
+		return "", nil, nil, fmt.Errorf("the proto message %s must have a uint64 id to be stored in a list", o.protoMessage)
+	}
+	if _, ok := found[signer]; signer != "" && !ok {
+		return "", nil, nil, fmt.Errorf(
+			"the proto message %s must have a string %s field holding the signer of the messages, or use --no-message",
+			o.protoMessage,
+			signer,
+		)
+	}
+	for _, index := range o.indexes {
+		parts := strings.Split(index, datatype.Separator)
+		name, err := multiformatname.NewName(parts[0])
+		if err != nil {
+			return "", nil, nil, err
+		}
+		typeName := indexTypes[name.LowerCamel]
+		if typeName == "" {
+			return "", nil, nil, fmt.Errorf("the index %s is not a field of the proto message %s", parts[0], o.protoMessage)
+		}
+		if len(parts) > 1 && datatype.Name(parts[1]) != datatype.Name(typeName) {
+			return "", nil, nil, fmt.Errorf("the index %s is a %s in the proto message %s", parts[0], typeName, o.protoMessage)
+		}
+		indexes = append(indexes, name.LowerCamel+datatype.Separator+typeName)
+	}
+
+	protoImport = filepath.ToSlash(filepath.Join(moduleName, filepath.Base(protoFile)))
+	return protoImport, fields, indexes, nil
+}
+
+// protoFieldTypeName returns the scaffolder type name of a field of a proto message
+// the field can reference a custom type defined in the proto package of the module
+func protoFieldTypeName(
+	ctx context.Context,
+	protoDir,
+	message string,
+	messageField protoanalysis.MessageField,
+) (string, error) {
+	datatypeName, ok, err := protoFieldDatatype(messageField)
+	if err != nil {
+		return "", fmt.Errorf("the proto message %s: %w", message, err)
+	}
+	if ok {
+		return string(datatypeName), nil
+	}
+	if err := protoanalysis.HasMessages(ctx, protoDir, messageField.Type); err == nil {
+		if messageField.Repeated {
+			return "array." + messageField.Type, nil
+		}
+		return messageField.Type, nil
+	}
+
+	protoType := messageField.Type
+	if messageField.Repeated {
+		protoType = "repeated " + protoType
+	}
+	return "", fmt.Errorf(
+		"the field %s of the proto message %s has the unsupported type %s, "+
+			"the supported types are string, bool, int32, uint64, bytes, cosmos.base.v1beta1.Coin, "+
+			"google.protobuf.Timestamp, the repeated string, int32, uint64 and cosmos.base.v1beta1.Coin, "+
+			"and the messages of the module",
+		messageField.Name,
+		message,
+		protoType,
+	)
+}
@@ -0,0 +1,54 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/upgrade"
+)
+
+// upgradeNameRegexp matches the names of the software upgrades, e.g. v1.1
+var upgradeNameRegexp = regexp.MustCompile(`^v[0-9]+(\.[0-9]+)*$`)
+
+// AddUpgrade adds a new software upgrade handler to the app
+func (s Scaffolder) AddUpgrade(
+	ctx context.Context,
+	clip *clipper.Clipper,
+	upgradeName string,
+) (sm xgenny.SourceModification, err error) {
+	if !upgradeNameRegexp.MatchString(upgradeName) {
+		return sm, fmt.Errorf("invalid upgrade name %s, the name must be a version like v1.1", upgradeName)
+	}
+
+	opts := &upgrade.Options{
+		AppName:        s.modpath.Package,
+		AppPath:        s.path,
+		ModulePath:     s.modpath.RawPath,
+		UpgradeName:    upgradeName,
+		UpgradePackage: strings.ReplaceAll(upgradeName, ".", "_"),
+	}
+
+	// Check the upgrade is not already scaffolded
+	upgradePath := filepath.Join(s.path, "app", "upgrades", opts.UpgradePackage)
+	if _, err := os.Stat(upgradePath); err == nil {
+		return sm, fmt.Errorf("the upgrade %s is already created (%s exists)", upgradeName, upgradePath)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	g, err := upgrade.NewStargate(clip, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(clip, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
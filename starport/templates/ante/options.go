@@ -0,0 +1,13 @@
+package ante
+
+import (
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// Options ...
+type Options struct {
+	AppName       string
+	AppPath       string
+	ModulePath    string
+	DecoratorName multiformatname.Name
+}
@@ -0,0 +1,145 @@
+package ante
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+const (
+	// appAnteImport is the name of the import of the ante package of the app in app.go, the SDK ante package is
+	// already imported as ante
+	appAnteImport = "appante"
+
+	pathHandler = "app/ante/handler.go"
+)
+
+// NewStargate returns the generator to scaffold an ante decorator in a Stargate app
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(handlerModify(clip, opts))
+
+	template := xgenny.NewEmbedWalker(
+		fsStargate,
+		"stargate/",
+		opts.AppPath,
+	)
+	return g, Box(template, opts, g)
+}
+
+// AddHandler returns the generator to scaffold the ante handler chaining the decorators of the app
+// app.go is converted to use this ante handler instead of the default SDK ante handler
+func AddHandler(clip *clipper.Clipper, appPath, modulePath string) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsHandler, "handler/", appPath)
+	)
+
+	g.RunFn(appModify(clip, appPath, modulePath))
+
+	ctx := plush.NewContext()
+	ctx.Set("modulePath", modulePath)
+
+	g.Transformer(plushgen.Transformer(ctx))
+
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// app.go modification to use the ante handler of the app
+func appModify(clip *clipper.Clipper, appPath, modulePath string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if strings.Contains(content, appAnteImport+".NewAnteHandler(") {
+			return nil
+		}
+
+		// The options of the default ante handler are embedded in the options of the ante handler of the app
+		var converted bool
+		content, err = clip.ReplaceCodeSnippetsAt(
+			path,
+			content,
+			clipper.GoSelectStatementsReferencing,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"names":        "NewAnteHandler",
+			},
+			func(data interface{}) string {
+				statement := data.(string)
+				call := strings.Index(statement, "ante.NewAnteHandler(")
+				start := strings.Index(statement, "ante.HandlerOptions{")
+				end := strings.LastIndex(statement, "}")
+				if call < 0 || start < call || end < start {
+					return statement
+				}
+				converted = true
+				return fmt.Sprintf(`%[1]v%[2]v.NewAnteHandler(
+		%[2]v.HandlerOptions{
+			HandlerOptions: %[3]v,
+		},
+	)`, statement[:call], appAnteImport, statement[start:end+1])
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if !converted {
+			return fmt.Errorf("the ante handler of %s can't be converted, the default SDK ante handler isn't used", path)
+		}
+
+		template := `%[1]v "%[2]v/app/ante"`
+		importSnippet := fmt.Sprintf(template, appAnteImport, modulePath)
+		content, err = clip.PasteGoImportSnippetAt(path, content, importSnippet)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// handler.go modification to chain the decorator after the other decorators of the app
+func handlerModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, pathHandler)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `New%[1]vDecorator(options)`
+		content, err := clip.PasteGoAssignedCompositeNewElementSnippetAt(
+			path,
+			f.String(),
+			fmt.Sprintf(template, opts.DecoratorName.UpperCamel),
+			clipper.SelectOptions{
+				"functionName": "NewAnteHandler",
+				"variableName": "appDecorators",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
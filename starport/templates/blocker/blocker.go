@@ -0,0 +1,66 @@
+package blocker
+
+import (
+	"embed"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+	"github.com/tendermint/starport/starport/templates/testutil"
+)
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+
+	//go:embed queue/* queue/**/*
+	fsQueue embed.FS
+)
+
+func Box(opts *Options, g *genny.Generator) error {
+	if err := g.Box(xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)); err != nil {
+		return err
+	}
+	if opts.HasQueue() {
+		if err := g.Box(xgenny.NewEmbedWalker(fsQueue, "queue/", opts.AppPath)); err != nil {
+			return err
+		}
+	}
+
+	hook, err := multiformatname.NewName(string(opts.Hook))
+	if err != nil {
+		return err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("OwnerName", opts.OwnerName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("Hook", hook)
+	ctx.Set("BlockerName", opts.BlockerName)
+	ctx.Set("MethodName", opts.MethodName())
+	ctx.Set("HasQueue", opts.HasQueue())
+	ctx.Set("QueueType", opts.QueueType)
+	ctx.Set("QueueTypeProtoImport", opts.QueueTypeProtoImport)
+
+	// Used for proto package name
+	ctx.Set("formatOwnerName", xstrings.FormatUsername)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{hookName}}", hook.Snake))
+	g.Transformer(genny.Replace("{{blockerName}}", opts.BlockerName.Snake))
+
+	// Create the 'testutil' package with the test helpers
+	if err := testutil.Register(g, opts.AppPath); err != nil {
+		return err
+	}
+
+	return nil
+}
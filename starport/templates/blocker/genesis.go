@@ -0,0 +1,385 @@
+package blocker
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/templates/module"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+// genesisModify adds the items and the count of the queue of the blocker to the genesis of the module
+func genesisModify(clip *clipper.Clipper, opts *Options, g *genny.Generator) {
+	g.RunFn(genesisProtoModify(clip, opts))
+	g.RunFn(genesisTypesModify(clip, opts))
+	g.RunFn(genesisModuleModify(clip, opts))
+	g.RunFn(genesisTestsModify(clip, opts))
+	g.RunFn(genesisTypesTestsModify(clip, opts))
+}
+
+func genesisProtoModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "genesis.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := strings.ReplaceAll(f.String(), `
+import "gogoproto/gogo.proto";`, "")
+
+		templateProtoImport := `
+import "gogoproto/gogo.proto";
+import "%[1]v/%[2]v_queue.proto";`
+		importString := fmt.Sprintf(templateProtoImport, opts.ModuleName, opts.BlockerName.Snake)
+
+		content, err = clip.PasteProtoImportSnippetAt(path, content, importString)
+		if err != nil {
+			return err
+		}
+
+		templateProtoState := `  repeated %[1]vQueueItem %[2]vQueueItems = %[3]v [(gogoproto.nullable) = false];
+  uint64 %[2]vQueueCount = %[4]v;
+`
+		content, err = clip.PasteGeneratedCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectNewMessageFieldPosition,
+			clipper.SelectOptions{
+				"name": "GenesisState",
+			},
+			func(data interface{}) string {
+				highestNumber := data.(clipper.ProtoNewMessageFieldPositionData).HighestFieldNumber
+				return fmt.Sprintf(
+					templateProtoState,
+					opts.BlockerName.UpperCamel,
+					opts.BlockerName.LowerCamel,
+					highestNumber+1,
+					highestNumber+2,
+				)
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTypesModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// There can be duplicate imports of `"fmt"` when the command is run more
+		// times but the gofmt will remove the duplicate ones.
+		importSnippet := `"fmt"`
+		content, err := clip.PasteGoImportSnippetAt(path, f.String(), importSnippet)
+		if err != nil {
+			return err
+		}
+
+		templateTypesDefault := `%[1]vQueueItems: []%[1]vQueueItem{}`
+		funcArgSnippet := fmt.Sprintf(
+			templateTypesDefault,
+			opts.BlockerName.UpperCamel,
+		)
+
+		if strings.Count(content, typed.PlaceholderGenesisTypesDefault) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			funcArgSnippet += "\n" + typed.PlaceholderGenesisTypesDefault
+			content = clip.Replace(content, typed.PlaceholderGenesisTypesDefault, funcArgSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+				path,
+				content,
+				funcArgSnippet,
+				clipper.SelectOptions{
+					"functionName": "DefaultGenesis",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		templateTypesValidate := `// Check for duplicated id in the %[1]v queue
+	%[1]vQueueIdMap := make(map[uint64]bool)
+	%[1]vQueueCount := gs.Get%[2]vQueueCount()
+	for _, elem := range gs.%[2]vQueueItems {
+		if _, ok := %[1]vQueueIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for the %[1]v queue")
+		}
+		if elem.Id >= %[1]vQueueCount {
+			return fmt.Errorf("%[1]v queue id should be lower than the queue count")
+		}
+		%[1]vQueueIdMap[elem.Id] = true
+	}`
+		beforeReturnSnippet := fmt.Sprintf(
+			templateTypesValidate,
+			opts.BlockerName.LowerCamel,
+			opts.BlockerName.UpperCamel,
+		)
+
+		if strings.Count(content, typed.PlaceholderGenesisTypesValidate) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			beforeReturnSnippet += "\n" + typed.PlaceholderGenesisTypesValidate
+			content = clip.Replace(content, typed.PlaceholderGenesisTypesValidate, beforeReturnSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, beforeReturnSnippet, clipper.SelectOptions{
+				"functionName": "Validate",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisModuleModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+
+		templateModuleInit := `
+	// Set all the items of the %[1]v queue
+	for _, elem := range genState.%[2]vQueueItems {
+		k.Set%[2]vQueueItem(ctx, elem)
+	}
+
+	// Set the %[1]v queue count
+	k.Set%[2]vQueueCount(ctx, genState.%[2]vQueueCount)`
+		moduleInitSnippet := fmt.Sprintf(
+			templateModuleInit,
+			opts.BlockerName.LowerCamel,
+			opts.BlockerName.UpperCamel,
+		)
+
+		if strings.Count(content, typed.PlaceholderGenesisModuleInit) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			moduleInitSnippet += "\n" + typed.PlaceholderGenesisModuleInit
+			content = clip.Replace(content, typed.PlaceholderGenesisModuleInit, moduleInitSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectStartOfFunctionPosition,
+				clipper.SelectOptions{
+					"functionName": "InitGenesis",
+				},
+				moduleInitSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		templateModuleExport := `genesis.%[1]vQueueItems = k.GetAll%[1]vQueueItems(ctx)
+  genesis.%[1]vQueueCount = k.Get%[1]vQueueCount(ctx)`
+		moduleExport := fmt.Sprintf(
+			templateModuleExport,
+			opts.BlockerName.UpperCamel,
+		)
+
+		if strings.Count(content, typed.PlaceholderGenesisModuleExport) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			moduleExport += "\n" + typed.PlaceholderGenesisModuleExport
+			content = clip.Replace(content, typed.PlaceholderGenesisModuleExport, moduleExport)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, moduleExport, clipper.SelectOptions{
+				"functionName": "ExportGenesis",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTestsModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := pasteTimeImport(clip, path, f.String())
+		if err != nil {
+			return err
+		}
+
+		templateState := `%[1]vQueueItems: []types.%[1]vQueueItem{
+		{
+			ExecTime: time.Unix(0, 0).UTC(),
+			Id:       0,
+		},
+		{
+			ExecTime: time.Unix(1, 0).UTC(),
+			Id:       1,
+		},
+	},
+	%[1]vQueueCount: 2`
+		testStateSnippet := fmt.Sprintf(
+			templateState,
+			opts.BlockerName.UpperCamel,
+		)
+
+		if strings.Count(content, module.PlaceholderGenesisTestState) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			testStateSnippet += ",\n" + module.PlaceholderGenesisTestState
+			content = clip.Replace(content, module.PlaceholderGenesisTestState, testStateSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+				path,
+				content,
+				testStateSnippet,
+				clipper.SelectOptions{
+					"functionName": "newTestGenesisState",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		templateAssert := `require.ElementsMatch(t, genesisState.%[1]vQueueItems, got.%[1]vQueueItems)
+  require.Equal(t, genesisState.%[1]vQueueCount, got.%[1]vQueueCount)`
+		beforeReturnSnippet := fmt.Sprintf(
+			templateAssert,
+			opts.BlockerName.UpperCamel,
+		)
+
+		if strings.Count(content, module.PlaceholderGenesisTestAssert) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			beforeReturnSnippet += "\n" + module.PlaceholderGenesisTestAssert
+			content = clip.Replace(content, module.PlaceholderGenesisTestAssert, beforeReturnSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, beforeReturnSnippet, clipper.SelectOptions{
+				"functionName": "TestGenesis",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTypesTestsModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		templateValid := `%[1]vQueueItems: []types.%[1]vQueueItem{
+	{
+		Id: 0,
+	},
+	{
+		Id: 1,
+	},
+},
+%[1]vQueueCount: 2`
+		validFieldSnippet := fmt.Sprintf(
+			templateValid,
+			opts.BlockerName.UpperCamel,
+		)
+
+		if strings.Count(content, module.PlaceholderTypesGenesisValidField) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			validFieldSnippet += ",\n" + module.PlaceholderTypesGenesisValidField
+			content = clip.Replace(content, module.PlaceholderTypesGenesisValidField, validFieldSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+				path,
+				content,
+				validFieldSnippet,
+				clipper.SelectOptions{
+					"functionName": "newTestGenesisState",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		templateTests := `{
+	desc:     "duplicated %[2]v queue item",
+	genState: &types.GenesisState{
+		%[3]vQueueItems: []types.%[3]vQueueItem{
+			{
+				Id: 0,
+			},
+			{
+				Id: 0,
+			},
+		},
+		%[3]vQueueCount: 2,
+	},
+	valid:    false,
+},
+{
+	desc:     "invalid %[2]v queue count",
+	genState: &types.GenesisState{
+		%[3]vQueueItems: []types.%[3]vQueueItem{
+			{
+				Id: 1,
+			},
+		},
+		%[3]vQueueCount: 0,
+	},
+	valid:    false,
+},
+%[1]v`
+		replacementTests := fmt.Sprintf(
+			templateTests,
+			module.PlaceholderTypesGenesisTestcase,
+			opts.BlockerName.LowerCamel,
+			opts.BlockerName.UpperCamel,
+		)
+		content = clip.Replace(content, module.PlaceholderTypesGenesisTestcase, replacementTests)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// pasteTimeImport imports the time package in the Go code if it is not already imported
+func pasteTimeImport(clip *clipper.Clipper, path, content string) (string, error) {
+	if strings.Contains(content, `"time"`) {
+		return content, nil
+	}
+	return clip.PasteGoImportSnippetAt(path, content, `"time"`)
+}
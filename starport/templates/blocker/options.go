@@ -0,0 +1,41 @@
+package blocker
+
+import (
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// Hook is the ABCI method of the module calling the blocker
+type Hook string
+
+const (
+	HookBeginBlock Hook = "BeginBlock"
+	HookEndBlock   Hook = "EndBlock"
+)
+
+// Options ...
+type Options struct {
+	AppName     string
+	AppPath     string
+	ModuleName  string
+	ModulePath  string
+	OwnerName   string
+	Hook        Hook
+	BlockerName multiformatname.Name
+
+	// QueueType is the type of the items processed by the blocker from a time-ordered queue
+	// the blocker doesn't process a queue if the type is not set
+	QueueType multiformatname.Name
+
+	// QueueTypeProtoImport is the import path of the proto file defining the queue type
+	QueueTypeProtoImport string
+}
+
+// HasQueue returns true if the blocker processes a time-ordered queue
+func (opts *Options) HasQueue() bool {
+	return opts.QueueType.Original != ""
+}
+
+// MethodName returns the name of the keeper method called by the hook
+func (opts *Options) MethodName() string {
+	return string(opts.Hook) + opts.BlockerName.UpperCamel
+}
@@ -0,0 +1,116 @@
+package blocker
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+// NewStargate returns the generator to scaffold a blocker in a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(ModuleModify(clip, opts))
+	g.RunFn(AppModify(clip, opts))
+	if opts.HasQueue() {
+		genesisModify(clip, opts, g)
+	}
+
+	return g, Box(opts, g)
+}
+
+// ModuleModify calls the blocker from the BeginBlock or EndBlock method of the module
+// ModuleModify is meant to be used by the generators calling a keeper method from a hook of the module.
+func ModuleModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		selectOptions := clipper.SelectOptions{
+			"functionName": string(opts.Hook),
+			"receiverType": "AppModule",
+			"typeName":     "sdk.Context",
+		}
+
+		// Name the context parameter if it is unused
+		ctxName := "ctx"
+		content, err := clip.ReplaceCodeSnippetsAt(
+			path,
+			f.String(),
+			clipper.GoSelectFunctionParameterNames,
+			selectOptions,
+			func(data interface{}) string {
+				if name := data.(string); name != "_" {
+					ctxName = name
+				}
+				return ctxName
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		template := `am.keeper.%[1]v(%[2]v)`
+		snippet := fmt.Sprintf(template, opts.MethodName(), ctxName)
+		content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, snippet, selectOptions)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// AppModify adds the module to the order of the begin or end blockers of the app
+// the module manager only calls the BeginBlock and EndBlock methods of the modules of these orders
+func AppModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		snippet := fmt.Sprintf("%[1]vmoduletypes.ModuleName", opts.ModuleName)
+		content, err := clip.ReplaceCodeSnippetsAt(
+			path,
+			f.String(),
+			clipper.GoSelectStatementsReferencing,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"names":        fmt.Sprintf("SetOrder%vers", opts.Hook),
+			},
+			func(data interface{}) string {
+				statement := data.(string)
+				start, end := strings.Index(statement, "("), strings.LastIndex(statement, ")")
+
+				// The order already includes the module or all the modules of the manager
+				if start < 0 || end < start || strings.Contains(statement, snippet) || strings.Contains(statement, "(app.mm,") {
+					return statement
+				}
+
+				args := statement[start+1 : end]
+				trimmedArgs := strings.TrimRight(args, ", \n\t")
+				if strings.Contains(args[len(trimmedArgs):], "\n") {
+					// Keep one argument per line
+					return statement[:start+1] + trimmedArgs + ",\n" + snippet + ",\n" + statement[end:]
+				}
+				return statement[:start+1] + trimmedArgs + ", " + snippet + statement[end:]
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,15 @@
+package enum
+
+import (
+	"github.com/tendermint/starport/starport/templates/field"
+)
+
+// Options are the options to scaffold an enum declared by a field
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	OwnerName  string
+	Enum       field.Field
+}
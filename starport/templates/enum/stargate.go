@@ -0,0 +1,42 @@
+// Package enum provides the generator to scaffold the enums declared by fields with the format enum(value1,value2...).
+package enum
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
+)
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+)
+
+// NewStargate returns the generator to scaffold an enum in a Stargate module.
+// An enum already defined in the module is kept, the fields declaring an enum with the same name share it.
+func NewStargate(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("OwnerName", opts.OwnerName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("EnumName", opts.Enum.Name)
+	ctx.Set("EnumValues", opts.Enum.EnumValues)
+	ctx.Set("toUpper", strings.ToUpper)
+
+	// Used for proto package name
+	ctx.Set("formatOwnerName", xstrings.FormatUsername)
+
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{enumName}}", opts.Enum.Name.Snake))
+
+	return g, xgenny.Box(g, xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath))
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"embed"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/packd"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+	"github.com/tendermint/starport/starport/templates/testutil"
+)
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+)
+
+func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
+	if err := g.Box(box); err != nil {
+		return err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("EventName", opts.EventName)
+	ctx.Set("OwnerName", opts.OwnerName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("Fields", opts.Fields)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{eventName}}", opts.EventName.Snake))
+
+	// Create the 'testutil' package with the test helpers
+	if err := testutil.Register(g, opts.AppPath); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package event
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+)
+
+// NewStargate returns the generator to scaffold a typed event in a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoEventsModify(clip, opts))
+
+	template := xgenny.NewEmbedWalker(
+		fsStargate,
+		"stargate/",
+		opts.AppPath,
+	)
+	return g, Box(template, opts, g)
+}
+
+func protoEventsModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "events.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var eventFields string
+		for i, field := range opts.Fields {
+			eventFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
+		}
+
+		template := `
+
+message Event%[1]v {
+%[2]v}`
+		replacement := fmt.Sprintf(template,
+			opts.EventName.UpperCamel,
+			eventFields,
+		)
+		content, err := clip.PasteCodeSnippetAt(
+			path,
+			f.String(),
+			clipper.ProtoSelectLastPosition,
+			nil,
+			replacement,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Ensure custom types are imported
+		protoImports := opts.Fields.ProtoImports()
+		for _, f := range opts.Fields.Custom() {
+			protoImports = append(protoImports,
+				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
+			)
+		}
+		for _, f := range protoImports {
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, f)
+			content = strings.ReplaceAll(content, importModule, "")
+			content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,91 @@
+package expectedkeeper
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// imports names the packages imported by a Go file to qualify the types written in the file
+type imports struct {
+	pkg   *types.Package
+	names map[string]string
+	used  map[string]struct{}
+}
+
+// newImports returns the imports of a file of the package pkg, the names of the imports of the file are kept
+func newImports(pkg *types.Package, file *ast.File) *imports {
+	i := &imports{
+		pkg:   pkg,
+		names: make(map[string]string),
+		used:  make(map[string]struct{}),
+	}
+	if file == nil {
+		return i
+	}
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		i.names[importPath] = name
+	}
+	return i
+}
+
+// qualifier returns the name of the import of a package in the file
+// the packages not imported yet are named after their parent directory when their name is already used
+func (i *imports) qualifier(pkg *types.Package) string {
+	if pkg == i.pkg {
+		return ""
+	}
+	i.used[pkg.Path()] = struct{}{}
+	if name, ok := i.names[pkg.Path()]; ok {
+		return name
+	}
+
+	name := pkg.Name()
+	if i.isNameUsed(name) {
+		name = strings.ReplaceAll(path.Base(path.Dir(pkg.Path())), "-", "") + pkg.Name()
+	}
+	for n := 2; i.isNameUsed(name); n++ {
+		name = fmt.Sprintf("%s%d", pkg.Name(), n)
+	}
+	i.names[pkg.Path()] = name
+	return name
+}
+
+func (i *imports) isNameUsed(name string) bool {
+	for _, n := range i.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// paths returns the paths of the packages used to qualify types, sorted
+func (i *imports) paths() []string {
+	var paths []string
+	for importPath := range i.used {
+		paths = append(paths, importPath)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// spec returns the import spec of a package, the package is named only when its name differs from its path
+func (i *imports) spec(importPath string) string {
+	if name := i.names[importPath]; name != path.Base(importPath) {
+		return fmt.Sprintf("%s %q", name, importPath)
+	}
+	return strconv.Quote(importPath)
+}
@@ -0,0 +1,15 @@
+package expectedkeeper
+
+import (
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/keeper"
+)
+
+// Options ...
+type Options struct {
+	AppPath    string
+	ModulePath string
+	ModuleName string
+
+	// Module keeps the methods called by the module on the keepers it depends on
+	Module keeper.Module
+}
@@ -0,0 +1,317 @@
+package expectedkeeper
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/keeper"
+	"golang.org/x/mod/modfile"
+)
+
+const (
+	gomockPath = "github.com/golang/mock"
+
+	// gomockVersion is the version of gomock required by the Cosmos SDK
+	gomockVersion = "v1.6.0"
+)
+
+// NewStargate returns the generator filling the expected keepers of a Stargate module with the methods it calls
+// and generating the mocks of the expected keepers for the tests of the keeper
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(typesModify(clip, opts))
+	g.RunFn(mocksCreate(opts))
+	g.RunFn(goModModify(opts))
+
+	return g, nil
+}
+
+// expected_keepers.go modification to declare the methods called on each expected keeper
+func typesModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types", "expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, content, 0)
+		if err != nil {
+			return err
+		}
+		fileImports := newImports(opts.Module.Types, file)
+		imported := make(map[string]struct{})
+		for importPath := range fileImports.names {
+			imported[importPath] = struct{}{}
+		}
+
+		// Find the method list of the interfaces of the expected keepers
+		interfaces := make(map[string]*ast.InterfaceType)
+		ast.Inspect(file, func(n ast.Node) bool {
+			if spec, ok := n.(*ast.TypeSpec); ok {
+				if t, ok := spec.Type.(*ast.InterfaceType); ok {
+					interfaces[spec.Name.Name] = t
+				}
+			}
+			return true
+		})
+
+		type replacement struct {
+			start, end int
+			methods    string
+		}
+		var replacements []replacement
+		for _, dependency := range opts.Module.Dependencies {
+			t, ok := interfaces[dependency.Interface]
+			if !ok {
+				return fmt.Errorf("the expected keeper %s is not declared in %s", dependency.Interface, path)
+			}
+
+			methods := "\n"
+			for _, method := range dependency.Methods {
+				var signature bytes.Buffer
+				types.WriteSignature(&signature, method.Signature, fileImports.qualifier)
+				methods += fmt.Sprintf("\t%s%s\n", method.Name, signature.String())
+			}
+			replacements = append(replacements, replacement{
+				start:   int(t.Methods.Opening) - int(file.Pos()) + 1,
+				end:     int(t.Methods.Closing) - int(file.Pos()),
+				methods: methods,
+			})
+		}
+
+		// Replace the method lists from the end of the file so that the offsets of the remaining ones stay valid
+		sort.Slice(replacements, func(i, j int) bool {
+			return replacements[i].start > replacements[j].start
+		})
+		for _, r := range replacements {
+			content = content[:r.start] + r.methods + content[r.end:]
+		}
+
+		for _, importPath := range fileImports.paths() {
+			if _, ok := imported[importPath]; ok {
+				continue
+			}
+			content, err = clip.PasteGoImportSnippetAt(path, content, fileImports.spec(importPath))
+			if err != nil {
+				return err
+			}
+		}
+		content, err = clip.CutCodeSnippetsAt(path, content, clipper.GoSelectUnusedImports, nil)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// mocksCreate generates the mocks of the expected keepers in the keeper test utilities of the app
+func mocksCreate(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "testutil", "keeper", opts.ModuleName+"_mocks.go")
+
+		content, err := mocks(opts)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, string(content))
+		return r.File(newFile)
+	}
+}
+
+// go.mod modification to require gomock used by the mocks
+func goModModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "go.mod")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		modFile, err := modfile.Parse(path, []byte(f.String()), nil)
+		if err != nil {
+			return err
+		}
+		for _, require := range modFile.Require {
+			if require.Mod.Path == gomockPath {
+				return nil
+			}
+		}
+		if err := modFile.AddRequire(gomockPath, gomockVersion); err != nil {
+			return err
+		}
+		content, err := modFile.Format()
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, string(content))
+		return r.File(newFile)
+	}
+}
+
+// mocks returns the content of the file declaring the gomock mocks of the expected keepers
+func mocks(opts *Options) ([]byte, error) {
+	fileImports := newImports(nil, nil)
+	fileImports.names["reflect"] = "reflect"
+	fileImports.names[gomockPath+"/gomock"] = "gomock"
+
+	var (
+		body    bytes.Buffer
+		methods bool
+	)
+	for _, dependency := range opts.Module.Dependencies {
+		writeMock(&body, opts, dependency, fileImports)
+		methods = methods || len(dependency.Methods) > 0
+	}
+
+	// reflect is only used by the recorders of the methods
+	var content bytes.Buffer
+	content.WriteString("// Code generated by starport. DO NOT EDIT.\n\npackage keeper\n\nimport (\n")
+	if methods {
+		content.WriteString("\t\"reflect\"\n\n")
+	}
+	content.WriteString("\t\"github.com/golang/mock/gomock\"\n")
+	for _, importPath := range fileImports.paths() {
+		fmt.Fprintf(&content, "\t%s\n", fileImports.spec(importPath))
+	}
+	fmt.Fprintf(&content, ")\n%s", body.String())
+
+	return format.Source(content.Bytes())
+}
+
+// writeMock writes a gomock mock of an expected keeper
+func writeMock(w *bytes.Buffer, opts *Options, dependency keeper.Dependency, fileImports *imports) {
+	var (
+		mock     = fmt.Sprintf("Mock%s%s", strings.Title(opts.ModuleName), dependency.Interface)
+		recorder = mock + "MockRecorder"
+		expected = types.TypeString(opts.Module.Types.Scope().Lookup(dependency.Interface).Type(), fileImports.qualifier)
+	)
+
+	fmt.Fprintf(w, `
+var _ %[3]v = (*%[1]v)(nil)
+
+// %[1]v is a mock of the %[4]v expected by the %[5]v module
+type %[1]v struct {
+	ctrl     *gomock.Controller
+	recorder *%[2]v
+}
+
+// %[2]v is the mock recorder for %[1]v
+type %[2]v struct {
+	mock *%[1]v
+}
+
+// New%[1]v creates a new mock instance
+func New%[1]v(ctrl *gomock.Controller) *%[1]v {
+	mock := &%[1]v{ctrl: ctrl}
+	mock.recorder = &%[2]v{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *%[1]v) EXPECT() *%[2]v {
+	return m.recorder
+}
+`, mock, recorder, expected, dependency.Interface, opts.ModuleName)
+
+	for _, method := range dependency.Methods {
+		var (
+			sig      = method.Signature
+			params   []string
+			args     []string
+			results  []string
+			variadic string
+		)
+		for i := 0; i < sig.Params().Len(); i++ {
+			arg := fmt.Sprintf("arg%d", i)
+			paramType := sig.Params().At(i).Type()
+			if sig.Variadic() && i == sig.Params().Len()-1 {
+				variadic = arg
+				paramType = paramType.(*types.Slice).Elem()
+				params = append(params, fmt.Sprintf("%s ...%s", arg, types.TypeString(paramType, fileImports.qualifier)))
+				continue
+			}
+			args = append(args, arg)
+			params = append(params, fmt.Sprintf("%s %s", arg, types.TypeString(paramType, fileImports.qualifier)))
+		}
+		for i := 0; i < sig.Results().Len(); i++ {
+			results = append(results, types.TypeString(sig.Results().At(i).Type(), fileImports.qualifier))
+		}
+
+		// Mocked method
+		resultList := ""
+		if len(results) > 0 {
+			resultList = fmt.Sprintf(" (%s)", strings.Join(results, ", "))
+		}
+		fmt.Fprintf(w, `
+// %[2]v mocks base method
+func (m *%[1]v) %[2]v(%[3]v)%[4]v {
+	m.ctrl.T.Helper()
+`, mock, method.Name, strings.Join(params, ", "), resultList)
+
+		callArgs := strings.Join(append([]string{"m", fmt.Sprintf("%q", method.Name)}, args...), ", ")
+		if variadic != "" {
+			fmt.Fprintf(w, `	varargs := []interface{}{%[1]v}
+	for _, a := range %[2]v {
+		varargs = append(varargs, a)
+	}
+`, strings.Join(args, ", "), variadic)
+			callArgs = fmt.Sprintf("m, %q, varargs...", method.Name)
+		}
+
+		if len(results) == 0 {
+			fmt.Fprintf(w, "\tm.ctrl.Call(%s)\n}\n", callArgs)
+		} else {
+			fmt.Fprintf(w, "\tret := m.ctrl.Call(%s)\n", callArgs)
+			var rets []string
+			for i, result := range results {
+				fmt.Fprintf(w, "\tret%[1]d, _ := ret[%[1]d].(%[2]v)\n", i, result)
+				rets = append(rets, fmt.Sprintf("ret%d", i))
+			}
+			fmt.Fprintf(w, "\treturn %s\n}\n", strings.Join(rets, ", "))
+		}
+
+		// Recorder of the expected calls
+		recorderParams := ""
+		if len(args) > 0 {
+			recorderParams = strings.Join(args, ", ") + " interface{}"
+		}
+		recordArgs := strings.Join(args, ", ")
+		if variadic != "" {
+			if recorderParams != "" {
+				recorderParams += ", "
+			}
+			recorderParams += variadic + " ...interface{}"
+			recordArgs = "varargs..."
+		}
+		fmt.Fprintf(w, `
+// %[2]v indicates an expected call of %[2]v
+func (mr *%[1]v) %[2]v(%[3]v) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+`, recorder, method.Name, recorderParams)
+		if variadic != "" {
+			fmt.Fprintf(w, "\tvarargs := append([]interface{}{%s}, %s...)\n", strings.Join(args, ", "), variadic)
+		}
+		record := []string{"mr.mock", fmt.Sprintf("%q", method.Name), fmt.Sprintf("reflect.TypeOf((*%s)(nil).%s)", mock, method.Name)}
+		if recordArgs != "" {
+			record = append(record, recordArgs)
+		}
+		fmt.Fprintf(w, "\treturn mr.mock.ctrl.RecordCallWithMethodType(%s)\n}\n", strings.Join(record, ", "))
+	}
+}
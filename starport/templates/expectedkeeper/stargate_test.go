@@ -0,0 +1,136 @@
+package expectedkeeper
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/keeper"
+)
+
+// gomockStub declares the part of the gomock API used by the mocks
+const gomockStub = `package gomock
+
+import "reflect"
+
+type TestHelper interface{ Helper() }
+
+type Controller struct{ T TestHelper }
+
+type Call struct{}
+
+func (*Controller) Call(receiver interface{}, method string, args ...interface{}) []interface{} { return nil }
+
+func (*Controller) RecordCallWithMethodType(receiver interface{}, method string, methodType reflect.Type, args ...interface{}) *Call {
+	return nil
+}
+`
+
+// testImporter imports the packages type checked by the test and the standard library from source
+type testImporter struct {
+	pkgs map[string]*types.Package
+	std  types.Importer
+}
+
+func (i testImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := i.pkgs[path]; ok {
+		return pkg, nil
+	}
+	return i.std.Import(path)
+}
+
+func check(t *testing.T, fileSet *token.FileSet, imp types.Importer, path, src string) *types.Package {
+	t.Helper()
+
+	f, err := parser.ParseFile(fileSet, path+".go", src, 0)
+	require.NoError(t, err)
+	pkg, err := (&types.Config{Importer: imp}).Check(path, fileSet, []*ast.File{f}, nil)
+	require.NoError(t, err)
+	return pkg
+}
+
+func TestMocks(t *testing.T) {
+	var (
+		fileSet = token.NewFileSet()
+		imp     = testImporter{
+			pkgs: make(map[string]*types.Package),
+			std:  importer.ForCompiler(fileSet, "source", nil),
+		}
+	)
+	imp.pkgs["github.com/golang/mock/gomock"] = check(t, fileSet, imp, "github.com/golang/mock/gomock", gomockStub)
+	imp.pkgs["github.com/test/earth/x/bank"] = check(t, fileSet, imp, "github.com/test/earth/x/bank", `package bank
+
+type Coin struct{}
+
+type Keeper struct{}
+
+func (Keeper) GetBalance(addr string) Coin { return Coin{} }
+
+func (Keeper) SendCoins(from, to string, amounts ...Coin) error { return nil }
+
+func (Keeper) Burn() {}
+`)
+	mars := check(t, fileSet, imp, "github.com/test/earth/x/mars/types", `package types
+
+type BankKeeper interface{}
+`)
+	imp.pkgs[mars.Path()] = mars
+
+	bankKeeper := imp.pkgs["github.com/test/earth/x/bank"].Scope().Lookup("Keeper").Type()
+	var methods []keeper.Method
+	for _, name := range []string{"Burn", "GetBalance", "SendCoins"} {
+		obj, _, _ := types.LookupFieldOrMethod(bankKeeper, true, nil, name)
+		methods = append(methods, keeper.Method{
+			Name:      name,
+			Signature: obj.Type().(*types.Signature),
+		})
+	}
+
+	content, err := mocks(&Options{
+		ModuleName: "mars",
+		Module: keeper.Module{
+			Types: mars,
+			Dependencies: []keeper.Dependency{{
+				Interface: "BankKeeper",
+				Keeper:    bankKeeper,
+				Methods:   methods,
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	// The mocks must compile and implement the methods called by the module
+	mocksPkg := check(t, fileSet, imp, "github.com/test/earth/testutil/keeper", string(content))
+	mock := mocksPkg.Scope().Lookup("MockMarsBankKeeper")
+	require.NotNil(t, mock, "the mock is not declared")
+	recorder := mocksPkg.Scope().Lookup("MockMarsBankKeeperMockRecorder")
+	require.NotNil(t, recorder, "the mock recorder is not declared")
+
+	for _, method := range methods {
+		obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(mock.Type()), true, mocksPkg, method.Name)
+		require.NotNil(t, obj, "the mock doesn't implement %s", method.Name)
+		require.True(
+			t,
+			types.Identical(method.Signature, obj.Type()),
+			"the signature of %s differs from the one of the keeper", method.Name,
+		)
+
+		obj, _, _ = types.LookupFieldOrMethod(types.NewPointer(recorder.Type()), true, mocksPkg, method.Name)
+		require.NotNil(t, obj, "the mock recorder doesn't record %s", method.Name)
+	}
+
+	// The mocks of the keepers without any called method must compile as well
+	content, err = mocks(&Options{
+		ModuleName: "mars",
+		Module: keeper.Module{
+			Types:        mars,
+			Dependencies: []keeper.Dependency{{Interface: "BankKeeper", Keeper: bankKeeper}},
+		},
+	})
+	require.NoError(t, err)
+	check(t, fileSet, imp, "github.com/test/earth/testutil/keeper", string(content))
+}
@@ -0,0 +1,33 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+var (
+	// DataAddress address data type definition
+	DataAddress = DataType{
+		DataType:         func(string) string { return "string" },
+		DefaultTestValue: "cosmos1wd6xzunsdae8ghm5v4ehghmpv3j8yetnelcx7m",
+		JSONTestValue:    `"cosmos1wd6xzunsdae8ghm5v4ehghmpv3j8yetnelcx7m"`,
+		SampleTestValue:  "sample.AccAddress()",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("string %s = %d", name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, _ int) string {
+			return fmt.Sprintf("%s: sample.AccAddress(),\n", name.UpperCamel)
+		},
+		GenesisArgsSample: true,
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf("%s%s := args[%d]", prefix, name.UpperCamel, argIndex)
+		},
+		ValidateBasic: func(name multiformatname.Name, prefix string) string {
+			return fmt.Sprintf(`if _, err := sdk.AccAddressFromBech32(%[1]v%[2]v); err != nil {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %[3]v address (%%s)", err)
+				}`, prefix, name.UpperCamel, name.LowerCamel)
+		},
+		NonIndex: true,
+	}
+)
@@ -11,9 +11,10 @@ var (
 	DataBool = DataType{
 		DataType:          func(string) string { return "bool" },
 		DefaultTestValue:  "false",
-		ValueLoop:         "false",
-		ValueIndex:        "false",
-		ValueInvalidIndex: "false",
+		JSONTestValue:     "false",
+		ValueLoop:         func(string) string { return "false" },
+		ValueIndex:        func(string) string { return "false" },
+		ValueInvalidIndex: func(string) string { return "false" },
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("bool %s = %d", name, index)
 		},
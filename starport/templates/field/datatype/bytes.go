@@ -0,0 +1,34 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+var (
+	// DataBytes bytes data type definition
+	DataBytes = DataType{
+		DataType:          func(string) string { return "[]byte" },
+		DefaultTestValue:  "xyz",
+		JSONTestValue:     `"eHl6"`,
+		ValueLoop:         func(string) string { return "[]byte(strconv.Itoa(i))" },
+		ValueIndex:        func(string) string { return "[]byte(strconv.Itoa(0))" },
+		ValueInvalidIndex: func(string) string { return "[]byte(strconv.Itoa(100000))" },
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("bytes %s = %d", name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: []byte(\"%d\"),\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf("%s%s := []byte(args[%d])", prefix, name.UpperCamel, argIndex)
+		},
+		ToBytes: func(name string) string {
+			return fmt.Sprintf("%[1]vBytes := %[1]v", name)
+		},
+		ToString: func(name string) string {
+			return fmt.Sprintf("string(%s)", name)
+		},
+	}
+)
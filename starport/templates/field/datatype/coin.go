@@ -11,6 +11,7 @@ var (
 	DataCoin = DataType{
 		DataType:         func(string) string { return "sdk.Coin" },
 		DefaultTestValue: "10token",
+		JSONTestValue:    `{"denom":"token","amount":"10"}`,
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
@@ -31,6 +32,7 @@ var (
 	DataCoinSlice = DataType{
 		DataType:         func(string) string { return "sdk.Coins" },
 		DefaultTestValue: "10token,20stake",
+		JSONTestValue:    `[{"denom":"token","amount":"10"},{"denom":"stake","amount":"20"}]`,
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
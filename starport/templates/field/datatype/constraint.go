@@ -0,0 +1,497 @@
+package datatype
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// Constraint is the name of a validation constraint declared with the format type{constraint=value,...}
+type Constraint string
+
+const (
+	// ConstraintMin is the minimum value of a number or the minimum length of a string
+	ConstraintMin Constraint = "min"
+	// ConstraintMax is the maximum value of a number or the maximum length of a string
+	ConstraintMax Constraint = "max"
+	// ConstraintRegex is the regular expression a string must match
+	ConstraintRegex Constraint = "regex"
+	// ConstraintMinLen is the minimum number of elements of an array
+	ConstraintMinLen Constraint = "minlen"
+	// ConstraintMaxLen is the maximum number of elements of an array
+	ConstraintMaxLen Constraint = "maxlen"
+)
+
+// FieldConstraint is a validation constraint declared for a field with its value
+type FieldConstraint struct {
+	Name  Constraint
+	Value string
+}
+
+// TestValue is a value used in the tests of a field
+type TestValue struct {
+	// Arg is the value provided as a CLI argument
+	Arg string
+
+	// Code is the Go expression of the value
+	Code string
+}
+
+// Validator generates the validation of the constraints supported by a data type
+type Validator struct {
+	// Constraints are the constraints supported by the data type
+	Constraints []Constraint
+
+	// Validate checks the values of the constraints declared for a field
+	Validate func(constraints []FieldConstraint) error
+
+	// Check returns the code returning an error if the value of the field doesn't satisfy the constraint
+	// the value is the Go expression of the value, the variables of the checks are named after the scope
+	Check func(name multiformatname.Name, value, scope string, constraint FieldConstraint) string
+
+	// Var returns the declaration of the package-level variable used by the check of the constraint
+	// empty if the check doesn't use a variable, nil if none of the checks use one
+	Var func(name multiformatname.Name, scope string, constraint FieldConstraint) string
+
+	// GoImports returns the packages imported by the check of the constraint, nil if the checks import none
+	GoImports func(constraint FieldConstraint) []GoImport
+
+	// Valid returns a test value satisfying all the constraints
+	Valid func(constraints []FieldConstraint) (TestValue, error)
+
+	// Invalid returns the Go expression of a value not satisfying the constraint
+	// false is returned if every value of the data type satisfies the constraint
+	Invalid func(constraint FieldConstraint) (string, bool)
+}
+
+// Supports returns true if the constraint is supported by the validator
+func (v *Validator) Supports(constraint Constraint) bool {
+	for _, c := range v.Constraints {
+		if c == constraint {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseConstraints parses a type name declaring constraints with the format type{constraint=value,...}
+// the type name is returned without its constraints
+func ParseConstraints(name Name) (Name, []FieldConstraint, error) {
+	opening := strings.Index(string(name), "{")
+	if opening < 0 {
+		return name, nil, nil
+	}
+	if !strings.HasSuffix(string(name), "}") {
+		return name, nil, fmt.Errorf("the constraints of %s must be declared with the format type{constraint=value,...}", name)
+	}
+	typeName := name[:opening]
+	declaration := string(name[opening+1 : len(name)-1])
+
+	// Regular expressions can contain commas, a comma only separates two constraints
+	// if it is followed by the name of a constraint
+	var declarations []string
+	for _, elem := range strings.Split(declaration, ",") {
+		if len(declarations) > 0 && !isConstraintDeclaration(elem) {
+			declarations[len(declarations)-1] += "," + elem
+			continue
+		}
+		declarations = append(declarations, elem)
+	}
+
+	constraints := make([]FieldConstraint, 0, len(declarations))
+	existing := make(map[Constraint]struct{})
+	for _, elem := range declarations {
+		if !isConstraintDeclaration(elem) {
+			return typeName, nil, fmt.Errorf("invalid constraint %s, should be 'constraint=value'", elem)
+		}
+		split := strings.SplitN(elem, "=", 2)
+		constraint := Constraint(strings.TrimSpace(split[0]))
+		if _, ok := existing[constraint]; ok {
+			return typeName, nil, fmt.Errorf("the constraint %s is duplicated", constraint)
+		}
+		existing[constraint] = struct{}{}
+		constraints = append(constraints, FieldConstraint{
+			Name:  constraint,
+			Value: strings.TrimSpace(split[1]),
+		})
+	}
+	return typeName, constraints, nil
+}
+
+var constraintDeclarationRe = regexp.MustCompile(`^\s*[a-z]+\s*=`)
+
+func isConstraintDeclaration(declaration string) bool {
+	return constraintDeclarationRe.MatchString(declaration)
+}
+
+// invalidRequest returns the code returning an invalid request error if the condition is true
+func invalidRequest(condition, format string, args ...interface{}) string {
+	return fmt.Sprintf(`if %s {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, %s)
+	}`, condition, strconv.Quote(fmt.Sprintf(format, args...)))
+}
+
+// regexVar returns the name of the variable holding the compiled regular expression of a field
+func regexVar(name multiformatname.Name, scope string) string {
+	if scope == "" {
+		return name.LowerCamel + "Re"
+	}
+	return scope + name.UpperCamel + "Re"
+}
+
+// stringValidator returns the validator of the strings, the length and the format of the string can be constrained
+func stringValidator() *Validator {
+	parse := func(constraints []FieldConstraint) (minLen, maxLen int, re *regexp.Regexp, err error) {
+		maxLen = -1
+		for _, c := range constraints {
+			switch c.Name {
+			case ConstraintMin:
+				if minLen, err = strconv.Atoi(c.Value); err != nil || minLen < 0 {
+					return 0, 0, nil, fmt.Errorf("the %s length %s must be a positive integer", c.Name, c.Value)
+				}
+			case ConstraintMax:
+				if maxLen, err = strconv.Atoi(c.Value); err != nil || maxLen < 0 {
+					return 0, 0, nil, fmt.Errorf("the %s length %s must be a positive integer", c.Name, c.Value)
+				}
+			case ConstraintRegex:
+				if re, err = regexp.Compile(c.Value); err != nil {
+					return 0, 0, nil, fmt.Errorf("invalid regular expression %s: %s", c.Value, err.Error())
+				}
+			}
+		}
+		if maxLen >= 0 && minLen > maxLen {
+			return 0, 0, nil, fmt.Errorf("the min length %d is greater than the max length %d", minLen, maxLen)
+		}
+		return minLen, maxLen, re, nil
+	}
+
+	return &Validator{
+		Constraints: []Constraint{ConstraintMin, ConstraintMax, ConstraintRegex},
+		Validate: func(constraints []FieldConstraint) error {
+			_, _, _, err := parse(constraints)
+			return err
+		},
+		Check: func(name multiformatname.Name, value, scope string, c FieldConstraint) string {
+			switch c.Name {
+			case ConstraintMin:
+				return invalidRequest(fmt.Sprintf("utf8.RuneCountInString(%s) < %s", value, c.Value),
+					"%s must have at least %s characters", name.LowerCamel, c.Value)
+			case ConstraintMax:
+				return invalidRequest(fmt.Sprintf("utf8.RuneCountInString(%s) > %s", value, c.Value),
+					"%s must have at most %s characters", name.LowerCamel, c.Value)
+			case ConstraintRegex:
+				return invalidRequest(
+					fmt.Sprintf("!%s.MatchString(%s)", regexVar(name, scope), value),
+					"%s must match the regular expression %s", name.LowerCamel, c.Value,
+				)
+			}
+			return ""
+		},
+		Var: func(name multiformatname.Name, scope string, c FieldConstraint) string {
+			if c.Name != ConstraintRegex {
+				return ""
+			}
+			// The regular expression is compiled once instead of on each validation
+			return fmt.Sprintf("var %s = regexp.MustCompile(%s)", regexVar(name, scope), strconv.Quote(c.Value))
+		},
+		GoImports: func(c FieldConstraint) []GoImport {
+			switch c.Name {
+			case ConstraintMin, ConstraintMax:
+				return []GoImport{{Name: "unicode/utf8"}}
+			case ConstraintRegex:
+				return []GoImport{{Name: "regexp"}}
+			}
+			return nil
+		},
+		Valid: func(constraints []FieldConstraint) (TestValue, error) {
+			minLen, maxLen, re, err := parse(constraints)
+			if err != nil {
+				return TestValue{}, err
+			}
+			isValid := func(s string) bool {
+				length := utf8.RuneCountInString(s)
+				return length >= minLen && (maxLen < 0 || length <= maxLen) && (re == nil || re.MatchString(s))
+			}
+
+			var candidates []string
+			if re != nil {
+				// The repetitions of the regular expression are expanded until the length is valid
+				for repeat := 1; repeat <= minLen+1; repeat++ {
+					sample, err := regexSample(re.String(), repeat)
+					if err != nil {
+						return TestValue{}, err
+					}
+					candidates = append(candidates, sample)
+				}
+			} else {
+				candidates = append(candidates, "xyz", strings.Repeat("a", minLen))
+			}
+			for _, candidate := range candidates {
+				if isValid(candidate) {
+					return TestValue{Arg: candidate, Code: strconv.Quote(candidate)}, nil
+				}
+			}
+			return TestValue{}, errors.New("no test value satisfying the constraints can be generated")
+		},
+		Invalid: func(c FieldConstraint) (string, bool) {
+			switch c.Name {
+			case ConstraintMin:
+				minLen, _ := strconv.Atoi(c.Value)
+				if minLen == 0 {
+					return "", false
+				}
+				return strconv.Quote(strings.Repeat("a", minLen-1)), true
+			case ConstraintMax:
+				maxLen, _ := strconv.Atoi(c.Value)
+				return strconv.Quote(strings.Repeat("a", maxLen+1)), true
+			case ConstraintRegex:
+				re := regexp.MustCompile(c.Value)
+				for _, candidate := range []string{"", " ", "!", "a", "A", "0", "_", "-", "aA0_-!"} {
+					if !re.MatchString(candidate) {
+						return strconv.Quote(candidate), true
+					}
+				}
+			}
+			return "", false
+		},
+	}
+}
+
+// regexSample returns a string matching the regular expression
+// the repetitions of the expression are expanded the given number of times
+func regexSample(expr string, repeat int) (string, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+
+	var sample func(re *syntax.Regexp) string
+	sample = func(re *syntax.Regexp) string {
+		switch re.Op {
+		case syntax.OpLiteral:
+			return string(re.Rune)
+		case syntax.OpCharClass:
+			// Use a letter or a digit of the class when possible, or a printable character
+			for _, candidates := range []string{
+				"abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789",
+				"!#$%&()*+,-./:;<=>?@[]^_{|}~",
+			} {
+				for _, r := range candidates {
+					for i := 0; i+1 < len(re.Rune); i += 2 {
+						if r >= re.Rune[i] && r <= re.Rune[i+1] {
+							return string(r)
+						}
+					}
+				}
+			}
+			if len(re.Rune) > 0 {
+				return string(re.Rune[0])
+			}
+			return ""
+		case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+			return "a"
+		case syntax.OpCapture:
+			return sample(re.Sub[0])
+		case syntax.OpStar, syntax.OpPlus:
+			return strings.Repeat(sample(re.Sub[0]), repeat)
+		case syntax.OpQuest:
+			return ""
+		case syntax.OpRepeat:
+			count := re.Min
+			if count < repeat && (re.Max < 0 || repeat <= re.Max) {
+				count = repeat
+			}
+			return strings.Repeat(sample(re.Sub[0]), count)
+		case syntax.OpConcat:
+			var s string
+			for _, sub := range re.Sub {
+				s += sample(sub)
+			}
+			return s
+		case syntax.OpAlternate:
+			return sample(re.Sub[0])
+		}
+		return ""
+	}
+	return sample(re.Simplify()), nil
+}
+
+// numberValidator returns the validator of the integers of the given bit size, the value can be constrained
+func numberValidator(unsigned bool, bitSize int) *Validator {
+	parseValue := func(c FieldConstraint) (int64, error) {
+		if unsigned {
+			value, err := strconv.ParseUint(c.Value, 10, bitSize)
+			if err != nil || value > math.MaxInt64 {
+				return 0, fmt.Errorf("the %s value %s must be a positive integer", c.Name, c.Value)
+			}
+			return int64(value), nil
+		}
+		value, err := strconv.ParseInt(c.Value, 10, bitSize)
+		if err != nil {
+			return 0, fmt.Errorf("the %s value %s must be an integer", c.Name, c.Value)
+		}
+		return value, nil
+	}
+	parse := func(constraints []FieldConstraint) (min, max *int64, err error) {
+		for _, c := range constraints {
+			value, err := parseValue(c)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch c.Name {
+			case ConstraintMin:
+				min = &value
+			case ConstraintMax:
+				max = &value
+			}
+		}
+		if min != nil && max != nil && *min > *max {
+			return nil, nil, fmt.Errorf("the min value %d is greater than the max value %d", *min, *max)
+		}
+		return min, max, nil
+	}
+
+	var lowest, highest int64 = 0, math.MaxInt64
+	if !unsigned {
+		lowest, highest = -1<<(bitSize-1), 1<<(bitSize-1)-1
+	}
+
+	return &Validator{
+		Constraints: []Constraint{ConstraintMin, ConstraintMax},
+		Validate: func(constraints []FieldConstraint) error {
+			_, _, err := parse(constraints)
+			return err
+		},
+		Check: func(name multiformatname.Name, value, _ string, c FieldConstraint) string {
+			switch c.Name {
+			case ConstraintMin:
+				return invalidRequest(fmt.Sprintf("%s < %s", value, c.Value),
+					"%s must be greater than or equal to %s", name.LowerCamel, c.Value)
+			case ConstraintMax:
+				return invalidRequest(fmt.Sprintf("%s > %s", value, c.Value),
+					"%s must be less than or equal to %s", name.LowerCamel, c.Value)
+			}
+			return ""
+		},
+		Valid: func(constraints []FieldConstraint) (TestValue, error) {
+			min, max, err := parse(constraints)
+			if err != nil {
+				return TestValue{}, err
+			}
+			var value int64 = 111
+			if min != nil && value < *min {
+				value = *min
+			}
+			if max != nil && value > *max {
+				value = *max
+			}
+			s := strconv.FormatInt(value, 10)
+			return TestValue{Arg: s, Code: s}, nil
+		},
+		Invalid: func(c FieldConstraint) (string, bool) {
+			value, err := parseValue(c)
+			if err != nil {
+				return "", false
+			}
+			switch c.Name {
+			case ConstraintMin:
+				if value == lowest {
+					return "", false
+				}
+				return strconv.FormatInt(value-1, 10), true
+			case ConstraintMax:
+				if value == highest {
+					return "", false
+				}
+				return strconv.FormatInt(value+1, 10), true
+			}
+			return "", false
+		},
+	}
+}
+
+// sliceValidator returns the validator of the arrays, the number of elements of the array can be constrained
+// the elements of the test values are built from the element value
+func sliceValidator(goType string, element TestValue) *Validator {
+	parse := func(constraints []FieldConstraint) (minLen, maxLen int, err error) {
+		maxLen = -1
+		for _, c := range constraints {
+			value, err := strconv.Atoi(c.Value)
+			if err != nil || value < 0 {
+				return 0, 0, fmt.Errorf("the %s value %s must be a positive integer", c.Name, c.Value)
+			}
+			switch c.Name {
+			case ConstraintMinLen:
+				minLen = value
+			case ConstraintMaxLen:
+				maxLen = value
+			}
+		}
+		if maxLen >= 0 && minLen > maxLen {
+			return 0, 0, fmt.Errorf("the min length %d is greater than the max length %d", minLen, maxLen)
+		}
+		return minLen, maxLen, nil
+	}
+	values := func(length int) TestValue {
+		args := make([]string, length)
+		codes := make([]string, length)
+		for i := range args {
+			args[i], codes[i] = element.Arg, element.Code
+		}
+		return TestValue{
+			Arg:  strings.Join(args, ","),
+			Code: fmt.Sprintf("%s{%s}", goType, strings.Join(codes, ", ")),
+		}
+	}
+
+	return &Validator{
+		Constraints: []Constraint{ConstraintMinLen, ConstraintMaxLen},
+		Validate: func(constraints []FieldConstraint) error {
+			_, _, err := parse(constraints)
+			return err
+		},
+		Check: func(name multiformatname.Name, value, _ string, c FieldConstraint) string {
+			switch c.Name {
+			case ConstraintMinLen:
+				return invalidRequest(fmt.Sprintf("len(%s) < %s", value, c.Value),
+					"%s must have at least %s elements", name.LowerCamel, c.Value)
+			case ConstraintMaxLen:
+				return invalidRequest(fmt.Sprintf("len(%s) > %s", value, c.Value),
+					"%s must have at most %s elements", name.LowerCamel, c.Value)
+			}
+			return ""
+		},
+		Valid: func(constraints []FieldConstraint) (TestValue, error) {
+			minLen, maxLen, err := parse(constraints)
+			if err != nil {
+				return TestValue{}, err
+			}
+			// An empty array can't be provided as a CLI argument
+			length := minLen
+			if length == 0 && maxLen != 0 {
+				length = 1
+			}
+			return values(length), nil
+		},
+		Invalid: func(c FieldConstraint) (string, bool) {
+			length, _ := strconv.Atoi(c.Value)
+			switch c.Name {
+			case ConstraintMinLen:
+				if length == 0 {
+					return "", false
+				}
+				return values(length - 1).Code, true
+			case ConstraintMaxLen:
+				return values(length + 1).Code, true
+			}
+			return "", false
+		},
+	}
+}
@@ -2,14 +2,32 @@ package datatype
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 )
 
+// CustomSliceType returns the custom type of an array declaration with the format array.Type
+// false is returned if the type name doesn't declare an array of a custom type
+func CustomSliceType(name Name) (string, bool) {
+	if _, ok := SupportedTypes[name]; ok {
+		return "", false
+	}
+	if !strings.HasPrefix(string(name), arrayPrefix) {
+		return "", false
+	}
+	customType := strings.TrimPrefix(string(name), arrayPrefix)
+	if customType == "" {
+		return "", false
+	}
+	return customType, true
+}
+
 var (
 	// DataCustom custom data type definition
 	DataCustom = DataType{
 		DataType:         func(datatype string) string { return fmt.Sprintf("*%s", datatype) },
+		ExternalDataType: func(datatype string) string { return fmt.Sprintf("*types.%s", datatype) },
 		DefaultTestValue: "null",
 		ProtoType: func(datatype, name string, index int) string {
 			return fmt.Sprintf("%s %s = %d", datatype, name, index)
@@ -19,12 +37,39 @@ var (
 		},
 		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%[1]v%[2]v := new(types.%[3]v)
-					err = json.Unmarshal([]byte(args[%[4]v]), %[1]v%[2]v)
-    				if err != nil {
-                		return err
-            		}`, prefix, name.UpperCamel, datatype, argIndex)
+					%[1]v%[2]vDecoder := json.NewDecoder(strings.NewReader(args[%[4]v]))
+					%[1]v%[2]vDecoder.DisallowUnknownFields()
+					if err := %[1]v%[2]vDecoder.Decode(%[1]v%[2]v); err != nil {
+						return fmt.Errorf("invalid %[5]v: %%w", err)
+					}`, prefix, name.UpperCamel, datatype, argIndex, name.Original)
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}, {Name: "fmt"}, {Name: "strings"}},
+		NonIndex:     true,
+	}
+
+	// DataCustomSlice custom array data type definition
+	DataCustomSlice = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("[]*%s", datatype) },
+		ExternalDataType: func(datatype string) string { return fmt.Sprintf("[]*types.%s", datatype) },
+		DefaultTestValue: "[]",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("repeated %s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v := make([]*types.%[3]v, 0)
+					%[1]v%[2]vDecoder := json.NewDecoder(strings.NewReader(args[%[4]v]))
+					%[1]v%[2]vDecoder.DisallowUnknownFields()
+					if err := %[1]v%[2]vDecoder.Decode(&%[1]v%[2]v); err != nil {
+						return fmt.Errorf("invalid %[5]v, a JSON array is expected: %%w", err)
+					}
+					for i, item := range %[1]v%[2]v {
+						if item == nil {
+							return fmt.Errorf("invalid %[5]v, the element %%d is null", i)
+						}
+					}`, prefix, name.UpperCamel, datatype, argIndex, name.Original)
 		},
-		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}, {Name: "fmt"}, {Name: "strings"}},
 		NonIndex:     true,
 	}
 )
@@ -0,0 +1,34 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+var (
+	// DataDecimal decimal data type definition
+	DataDecimal = DataType{
+		DataType:         func(string) string { return "sdk.Dec" },
+		DefaultTestValue: "3.14",
+		JSONTestValue:    `"3.14"`,
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(
+				"string %s = %d [(gogoproto.customtype) = \"github.com/cosmos/cosmos-sdk/types.Dec\", (gogoproto.nullable) = false]",
+				name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: sdk.NewDec(%d),\n", name.UpperCamel, value)
+		},
+		GenesisArgsImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := sdk.NewDecFromStr(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		ProtoImports: []string{"gogoproto/gogo.proto"},
+		NonIndex:     true,
+	}
+)
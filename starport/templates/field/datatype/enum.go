@@ -0,0 +1,64 @@
+package datatype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// IsEnum checks if the type name is an enum declaration with the format enum(value1,value2...)
+func IsEnum(name Name) bool {
+	return strings.HasPrefix(string(name), string(Enum)+"(") && strings.HasSuffix(string(name), ")")
+}
+
+// EnumValues returns the values of an enum declaration
+func EnumValues(name Name) []string {
+	if !IsEnum(name) {
+		return nil
+	}
+	declaration := strings.TrimSuffix(strings.TrimPrefix(string(name), string(Enum)+"("), ")")
+
+	var values []string
+	for _, value := range strings.Split(declaration, ",") {
+		values = append(values, strings.TrimSpace(value))
+	}
+	return values
+}
+
+var (
+	// DataEnum enum data type definition, the enum type is defined in the types package of the module
+	DataEnum = DataType{
+		DataType:          func(datatype string) string { return datatype },
+		ExternalDataType:  func(datatype string) string { return "types." + datatype },
+		DefaultTestValue:  "0",
+		JSONTestValue:     "0",
+		ValueLoop:         func(datatype string) string { return fmt.Sprintf("types.%s(i)", datatype) },
+		ValueIndex:        func(datatype string) string { return fmt.Sprintf("types.%s(0)", datatype) },
+		ValueInvalidIndex: func(datatype string) string { return fmt.Sprintf("types.%s(100000)", datatype) },
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("%s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%[1]v: types.%[1]v(%[2]v),\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := types.Parse%s(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, datatype, argIndex)
+		},
+		ValidateBasic: func(name multiformatname.Name, prefix string) string {
+			return fmt.Sprintf(`if err := %[1]v%[2]v.Validate(); err != nil {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %[3]v (%%s)", err)
+				}`, prefix, name.UpperCamel, name.LowerCamel)
+		},
+		ToBytes: func(name string) string {
+			return fmt.Sprintf(`%[1]vBytes := make([]byte, 4)
+  					binary.BigEndian.PutUint32(%[1]vBytes, uint32(%[1]v))`, name)
+		},
+		ToString: func(name string) string {
+			return fmt.Sprintf("strconv.Itoa(int(%s))", name)
+		},
+	}
+)
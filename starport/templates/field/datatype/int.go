@@ -11,9 +11,10 @@ var (
 	DataInt = DataType{
 		DataType:          func(string) string { return "int32" },
 		DefaultTestValue:  "111",
-		ValueLoop:         "int32(i)",
-		ValueIndex:        "0",
-		ValueInvalidIndex: "100000",
+		JSONTestValue:     "111",
+		ValueLoop:         func(string) string { return "int32(i)" },
+		ValueIndex:        func(string) string { return "0" },
+		ValueInvalidIndex: func(string) string { return "100000" },
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("int32 %s = %d", name, index)
 		},
@@ -35,12 +36,14 @@ var (
 			return fmt.Sprintf("strconv.Itoa(int(%s))", name)
 		},
 		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		Validator:    numberValidator(false, 32),
 	}
 
 	// DataIntSlice int array data type definition
 	DataIntSlice = DataType{
 		DataType:         func(string) string { return "[]int32" },
 		DefaultTestValue: "1,2,3,4,5",
+		JSONTestValue:    "[1,2,3,4,5]",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated int32 %s = %d", name, index)
 		},
@@ -60,5 +63,6 @@ var (
 		},
 		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
 		NonIndex:     true,
+		Validator:    sliceValidator("[]int32", TestValue{Arg: "1", Code: "1"}),
 	}
 )
@@ -11,9 +11,10 @@ var (
 	DataString = DataType{
 		DataType:          func(string) string { return "string" },
 		DefaultTestValue:  "xyz",
-		ValueLoop:         "strconv.Itoa(i)",
-		ValueIndex:        "strconv.Itoa(0)",
-		ValueInvalidIndex: "strconv.Itoa(100000)",
+		JSONTestValue:     `"xyz"`,
+		ValueLoop:         func(string) string { return "strconv.Itoa(i)" },
+		ValueIndex:        func(string) string { return "strconv.Itoa(0)" },
+		ValueInvalidIndex: func(string) string { return "strconv.Itoa(100000)" },
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("string %s = %d", name, index)
 		},
@@ -29,12 +30,14 @@ var (
 		ToString: func(name string) string {
 			return name
 		},
+		Validator: stringValidator(),
 	}
 
 	// DataStringSlice string array data type definition
 	DataStringSlice = DataType{
 		DataType:         func(string) string { return "[]string" },
 		DefaultTestValue: "abc,xyz",
+		JSONTestValue:    `["abc","xyz"]`,
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated string %s = %d", name, index)
 		},
@@ -47,5 +50,6 @@ var (
 		},
 		GoCLIImports: []GoImport{{Name: "strings"}},
 		NonIndex:     true,
+		Validator:    sliceValidator("[]string", TestValue{Arg: "xyz", Code: `"xyz"`}),
 	}
 )
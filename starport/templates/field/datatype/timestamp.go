@@ -0,0 +1,35 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+var (
+	// DataTimestamp timestamp data type definition
+	DataTimestamp = DataType{
+		DataType:         func(string) string { return "time.Time" },
+		DefaultTestValue: "2021-01-01T00:00:00Z",
+		JSONTestValue:    `"2021-01-01T00:00:00Z"`,
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(
+				"google.protobuf.Timestamp %s = %d [(gogoproto.stdtime) = true, (gogoproto.nullable) = false]",
+				name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: time.Unix(%d, 0).UTC(),\n", name.UpperCamel, value)
+		},
+		GenesisArgsImports: []GoImport{{Name: "time"}},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.Parse(time.RFC3339, args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoTypeImports: []GoImport{{Name: "time"}},
+		GoCLIImports:  []GoImport{{Name: "time"}},
+		ProtoImports:  []string{"gogoproto/gogo.proto", "google/protobuf/timestamp.proto"},
+		NonIndex:      true,
+	}
+)
@@ -28,8 +28,20 @@ const (
 	Coin Name = "coin"
 	// Coins represents the coin array type name
 	Coins Name = "array.coin"
+	// Address represents the account address type name
+	Address Name = "address"
+	// Decimal represents the decimal type name
+	Decimal Name = "decimal"
+	// Timestamp represents the timestamp type name
+	Timestamp Name = "timestamp"
+	// Bytes represents the bytes type name
+	Bytes Name = "bytes"
+	// Enum represents the enum type name, declared with the format enum(value1,value2...)
+	Enum Name = "enum"
 	// Custom represents the custom type name
 	Custom Name = Name(TypeCustom)
+	// CustomSlice represents the custom type array name, declared with the format array.Type
+	CustomSlice Name = "array." + Custom
 
 	// StringSliceAlias represents the string array type name alias
 	StringSliceAlias Name = "strings"
@@ -39,9 +51,16 @@ const (
 	UintSliceAlias Name = "uints"
 	// CoinSliceAlias represents the coin array type name alias
 	CoinSliceAlias Name = "coins"
+	// Int32Alias represents the int type name alias
+	Int32Alias Name = "int32"
+	// Uint64Alias represents the uint type name alias
+	Uint64Alias Name = "uint64"
 
 	// TypeCustom represents the string type name id
 	TypeCustom = "customstarporttype"
+
+	// arrayPrefix is the prefix of the array type names
+	arrayPrefix = "array."
 )
 
 // SupportedTypes all support data types and definitions
@@ -51,15 +70,23 @@ var SupportedTypes = map[Name]DataType{
 	StringSliceAlias: DataStringSlice,
 	Bool:             DataBool,
 	Int:              DataInt,
+	Int32Alias:       DataInt,
 	IntSlice:         DataIntSlice,
 	IntSliceAlias:    DataIntSlice,
 	Uint:             DataUint,
+	Uint64Alias:      DataUint,
 	UintSlice:        DataUintSlice,
 	UintSliceAlias:   DataUintSlice,
 	Coin:             DataCoin,
 	Coins:            DataCoinSlice,
 	CoinSliceAlias:   DataCoinSlice,
+	Address:          DataAddress,
+	Decimal:          DataDecimal,
+	Timestamp:        DataTimestamp,
+	Bytes:            DataBytes,
+	Enum:             DataEnum,
 	Custom:           DataCustom,
+	CustomSlice:      DataCustomSlice,
 }
 
 // Name represents the Alias Name for the data type
@@ -67,19 +94,27 @@ type Name string
 
 // DataType represents the data types for code replacement
 type DataType struct {
-	DataType          func(datatype string) string
-	ProtoType         func(datatype, name string, index int) string
-	GenesisArgs       func(name multiformatname.Name, value int) string
-	ProtoImports      []string
-	GoCLIImports      []GoImport
-	DefaultTestValue  string
-	ValueLoop         string
-	ValueIndex        string
-	ValueInvalidIndex string
-	ToBytes           func(name string) string
-	ToString          func(name string) string
-	CLIArgs           func(name multiformatname.Name, datatype, prefix string, argIndex int) string
-	NonIndex          bool
+	DataType           func(datatype string) string
+	ExternalDataType   func(datatype string) string
+	ProtoType          func(datatype, name string, index int) string
+	GenesisArgs        func(name multiformatname.Name, value int) string
+	GenesisArgsSample  bool
+	GenesisArgsImports []GoImport
+	ProtoImports       []string
+	GoTypeImports      []GoImport
+	GoCLIImports       []GoImport
+	DefaultTestValue   string
+	SampleTestValue    string
+	JSONTestValue      string
+	ValueLoop          func(datatype string) string
+	ValueIndex         func(datatype string) string
+	ValueInvalidIndex  func(datatype string) string
+	ToBytes            func(name string) string
+	ToString           func(name string) string
+	CLIArgs            func(name multiformatname.Name, datatype, prefix string, argIndex int) string
+	ValidateBasic      func(name multiformatname.Name, prefix string) string
+	Validator          *Validator
+	NonIndex           bool
 }
 
 // GoImport represents the go import repo name with the alias
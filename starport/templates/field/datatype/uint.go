@@ -11,9 +11,10 @@ var (
 	DataUint = DataType{
 		DataType:          func(string) string { return "uint64" },
 		DefaultTestValue:  "111",
-		ValueLoop:         "uint64(i)",
-		ValueIndex:        "0",
-		ValueInvalidIndex: "100000",
+		JSONTestValue:     "111",
+		ValueLoop:         func(string) string { return "uint64(i)" },
+		ValueIndex:        func(string) string { return "0" },
+		ValueInvalidIndex: func(string) string { return "100000" },
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("uint64 %s = %d", name, index)
 		},
@@ -35,12 +36,14 @@ var (
 			return fmt.Sprintf("strconv.Itoa(int(%s))", name)
 		},
 		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		Validator:    numberValidator(true, 64),
 	}
 
 	// DataUintSlice uint array data type definition
 	DataUintSlice = DataType{
 		DataType:         func(string) string { return "[]uint64" },
 		DefaultTestValue: "1,2,3,4,5",
+		JSONTestValue:    "[1,2,3,4,5]",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated uint64 %s = %d", name, index)
 		},
@@ -61,5 +64,6 @@ var (
 		},
 		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
 		NonIndex:     true,
+		Validator:    sliceValidator("[]uint64", TestValue{Arg: "1", Code: "1"}),
 	}
 )
@@ -3,6 +3,7 @@ package field
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/templates/field/datatype"
@@ -14,6 +15,16 @@ type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
 	Datatype     string
+
+	// EnumValues are the values of the enum declared by the field
+	EnumValues []multiformatname.Name
+
+	// Constraints are the validation constraints declared with the type of the field
+	Constraints []datatype.FieldConstraint
+
+	// Nested are the fields of the type referenced by a custom field
+	// they are used to generate the test values of the field, nil if the type hasn't been analyzed
+	Nested Fields
 }
 
 // DataType returns the field Datatype
@@ -25,6 +36,18 @@ func (f Field) DataType() string {
 	return dt.DataType(f.Datatype)
 }
 
+// ExternalDataType returns the field Datatype referenced from outside the types package of the module
+func (f Field) ExternalDataType() string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.ExternalDataType == nil {
+		return dt.DataType(f.Datatype)
+	}
+	return dt.ExternalDataType(f.Datatype)
+}
+
 // ProtoType returns the field proto Datatype
 func (f Field) ProtoType(index int) string {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
@@ -40,9 +63,69 @@ func (f Field) DefaultTestValue() string {
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
+	if f.IsCustom() && f.Nested != nil {
+		value := f.Nested.jsonTestValue()
+		if f.DatatypeName == datatype.CustomSlice {
+			value = fmt.Sprintf("[%s]", value)
+		}
+		return value
+	}
+	if len(f.Constraints) > 0 {
+		return f.validTestValue().Arg
+	}
 	return dt.DefaultTestValue
 }
 
+// validTestValue returns the Datatype test value satisfying the constraints of the field
+func (f Field) validTestValue() datatype.TestValue {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	value, err := dt.Validator.Valid(f.Constraints)
+	if err != nil {
+		panic(fmt.Sprintf("invalid constraints for %s: %s", f.Name.Original, err.Error()))
+	}
+	return value
+}
+
+// InvalidTestValue is a value not satisfying a constraint of a field
+type InvalidTestValue struct {
+	Constraint string
+	Code       string
+}
+
+// InvalidTestValues returns the Go expressions of the values not satisfying each of the constraints of the field
+// the constraints satisfied by every value of the Datatype are skipped
+func (f Field) InvalidTestValues() []InvalidTestValue {
+	if len(f.Constraints) == 0 {
+		return nil
+	}
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	var values []InvalidTestValue
+	for _, c := range f.Constraints {
+		if code, ok := dt.Validator.Invalid(c); ok {
+			values = append(values, InvalidTestValue{Constraint: string(c.Name), Code: code})
+		}
+	}
+	return values
+}
+
+// jsonTestValue returns the Datatype value used in tests encoded in JSON, empty if the Datatype has no JSON value
+func (f Field) jsonTestValue() string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if f.IsCustom() {
+		return f.DefaultTestValue()
+	}
+	return dt.JSONTestValue
+}
+
 // ValueLoop returns the Datatype value for loop iteration
 func (f Field) ValueLoop() string {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
@@ -52,7 +135,7 @@ func (f Field) ValueLoop() string {
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
-	return dt.ValueLoop
+	return dt.ValueLoop(f.Datatype)
 }
 
 // ValueIndex returns the Datatype value for indexes
@@ -64,7 +147,7 @@ func (f Field) ValueIndex() string {
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
-	return dt.ValueIndex
+	return dt.ValueIndex(f.Datatype)
 }
 
 // ValueInvalidIndex returns the Datatype value for invalid indexes
@@ -76,7 +159,7 @@ func (f Field) ValueInvalidIndex() string {
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
-	return dt.ValueInvalidIndex
+	return dt.ValueInvalidIndex(f.Datatype)
 }
 
 // GenesisArgs returns the Datatype genesis args
@@ -85,9 +168,44 @@ func (f Field) GenesisArgs(value int) string {
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
+	if f.IsCustom() && f.Nested != nil {
+		args := fmt.Sprintf("&types.%s{\n%s}", f.Datatype, f.Nested.genesisArgs(value))
+		if f.DatatypeName == datatype.CustomSlice {
+			args = fmt.Sprintf("[]*types.%s{{\n%s}}", f.Datatype, f.Nested.genesisArgs(value))
+		}
+		return fmt.Sprintf("%s: %s,\n", f.Name.UpperCamel, args)
+	}
+	// The enum values are the declared values only
+	if values := f.EnumValueNames(); len(values) > 0 {
+		return fmt.Sprintf("%s: types.%s_%s,\n", f.Name.UpperCamel, f.Datatype, values[value%len(values)])
+	}
 	return dt.GenesisArgs(f.Name, value)
 }
 
+// GenesisArgsSample returns true if the genesis args of the field use the sample package of the app
+func (f Field) GenesisArgsSample() bool {
+	if f.IsCustom() && f.Nested != nil {
+		return f.Nested.GenesisArgsSample()
+	}
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GenesisArgsSample
+}
+
+// GenesisArgsImports returns the Datatype imports required by the genesis args of the field
+func (f Field) GenesisArgsImports() []datatype.GoImport {
+	if f.IsCustom() && f.Nested != nil {
+		return f.Nested.GenesisArgsImports()
+	}
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GenesisArgsImports
+}
+
 // CLIArgs returns the Datatype CLI args
 func (f Field) CLIArgs(prefix string, argIndex int) string {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
@@ -121,6 +239,122 @@ func (f Field) ToString(name string) string {
 	return dt.ToString(name)
 }
 
+// IsEnum returns true if the field declares an enum
+func (f Field) IsEnum() bool {
+	return f.DatatypeName == datatype.Enum
+}
+
+// EnumValueNames returns the names of the values of the proto enum declared by the field, prefixed with the enum name
+func (f Field) EnumValueNames() []string {
+	var names []string
+	for _, value := range f.EnumValues {
+		names = append(names, strings.ToUpper(f.Name.Snake+"_"+value.Snake))
+	}
+	return names
+}
+
+// IsCustom returns true if the field references a custom type or an array of a custom type
+func (f Field) IsCustom() bool {
+	return f.DatatypeName == datatype.Custom || f.DatatypeName == datatype.CustomSlice
+}
+
+// ValidateBasic returns the Datatype stateless validation of the field value and of its constraints
+// the value is referenced with the prefix, empty if the field has no validation
+// the variables used by the validation are named after the scope, see ValidationVars
+func (f Field) ValidateBasic(scope, prefix string) string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	var checks []string
+	if dt.ValidateBasic != nil {
+		checks = append(checks, dt.ValidateBasic(f.Name, prefix))
+	}
+	for _, c := range f.Constraints {
+		checks = append(checks, dt.Validator.Check(f.Name, prefix+f.Name.UpperCamel, scope, c))
+	}
+	return strings.Join(checks, "\n")
+}
+
+// ValidateConstraints returns the validation of the constraints of the field value held by the variable,
+// empty if the field has no constraints
+// the variables used by the validation are named after the scope, see ValidationVars
+func (f Field) ValidateConstraints(scope, variable string) string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	checks := make([]string, 0, len(f.Constraints))
+	for _, c := range f.Constraints {
+		checks = append(checks, dt.Validator.Check(f.Name, variable, scope, c))
+	}
+	return strings.Join(checks, "\n")
+}
+
+// ValidationVars returns the declarations of the package-level variables used by the validation of the
+// constraints of the field, like the compiled regular expressions, empty if the validation uses none
+func (f Field) ValidationVars(scope string) string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	var vars []string
+	for _, c := range f.Constraints {
+		if dt.Validator.Var == nil {
+			break
+		}
+		if v := dt.Validator.Var(f.Name, scope, c); v != "" {
+			vars = append(vars, v)
+		}
+	}
+	return strings.Join(vars, "\n")
+}
+
+// SampleTestValue returns the Datatype valid sample value used in tests, empty if the zero value is valid
+func (f Field) SampleTestValue() string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if len(f.Constraints) > 0 {
+		return f.validTestValue().Code
+	}
+	return dt.SampleTestValue
+}
+
+// SimulationValue returns the Go expression of the field value in the simulated messages, empty if the zero value
+// is valid, the simulated account is simAccount and the other values satisfy the constraints as in the tests
+func (f Field) SimulationValue() string {
+	if f.DatatypeName == datatype.Address {
+		return "simAccount.Address.String()"
+	}
+	return f.SampleTestValue()
+}
+
+// SimulationIndex returns the Go expression of the index value in the simulated messages from the random integer i,
+// the enum indexes are one of the declared values
+func (f Field) SimulationIndex() string {
+	if f.IsEnum() {
+		return fmt.Sprintf("types.%[1]v(int32(i %% len(types.%[1]v_name)))", f.Datatype)
+	}
+	return f.ValueLoop()
+}
+
+// GoTypeImports returns the Datatype imports required by the Go type and the validation of the field
+func (f Field) GoTypeImports() []datatype.GoImport {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	goImports := append([]datatype.GoImport{}, dt.GoTypeImports...)
+	for _, c := range f.Constraints {
+		if dt.Validator.GoImports != nil {
+			goImports = append(goImports, dt.Validator.GoImports(c)...)
+		}
+	}
+	return goImports
+}
+
 // GoCLIImports returns the Datatype imports for CLI package
 func (f Field) GoCLIImports() []datatype.GoImport {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
@@ -0,0 +1,169 @@
+package field
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+func TestNestedCustomTypes(t *testing.T) {
+	price := Field{
+		Name:         mustName(t, "price"),
+		Datatype:     "Price",
+		DatatypeName: datatype.Custom,
+		Nested: Fields{
+			{Name: mustName(t, "amount"), DatatypeName: datatype.Uint},
+			{Name: mustName(t, "denom"), DatatypeName: datatype.String},
+		},
+	}
+	items := Field{
+		Name:         mustName(t, "items"),
+		Datatype:     "LineItem",
+		DatatypeName: datatype.CustomSlice,
+		Nested: Fields{
+			{Name: mustName(t, "name"), DatatypeName: datatype.String},
+			{Name: mustName(t, "available"), DatatypeName: datatype.Bool},
+			price,
+		},
+	}
+
+	require.Equal(t, `{"amount":111,"denom":"xyz"}`, price.DefaultTestValue())
+	require.Equal(t, `[{"name":"xyz","available":false,"price":{"amount":111,"denom":"xyz"}}]`, items.DefaultTestValue())
+	require.Equal(t,
+		"Items: []*types.LineItem{{\nName: \"1\",\nAvailable: false,\nPrice: &types.Price{\nAmount: 1,\nDenom: \"1\",\n},\n}},\n",
+		items.GenesisArgs(1),
+	)
+
+	// Custom types that have not been analyzed keep their default values
+	price.Nested = nil
+	require.Equal(t, "null", price.DefaultTestValue())
+	require.Equal(t, "Price: new(types.Price),\n", price.GenesisArgs(1))
+}
+
+func TestAddressGenesisArgs(t *testing.T) {
+	owner := Field{Name: mustName(t, "owner"), DatatypeName: datatype.Address}
+	shipping := Field{
+		Name:         mustName(t, "shipping"),
+		Datatype:     "Shipping",
+		DatatypeName: datatype.Custom,
+		Nested:       Fields{owner},
+	}
+	title := Field{Name: mustName(t, "title"), DatatypeName: datatype.String}
+
+	require.Equal(t, "Owner: sample.AccAddress(),\n", owner.GenesisArgs(1))
+	require.True(t, Fields{title, owner}.GenesisArgsSample())
+	require.True(t, Fields{shipping}.GenesisArgsSample())
+	require.False(t, Fields{title}.GenesisArgsSample())
+}
+
+func TestGenesisArgsImports(t *testing.T) {
+	price := Field{Name: mustName(t, "price"), DatatypeName: datatype.Decimal}
+	deadline := Field{Name: mustName(t, "deadline"), DatatypeName: datatype.Timestamp}
+	auction := Field{
+		Name:         mustName(t, "auction"),
+		Datatype:     "Auction",
+		DatatypeName: datatype.Custom,
+		Nested:       Fields{price, deadline},
+	}
+	title := Field{Name: mustName(t, "title"), DatatypeName: datatype.String}
+
+	require.Equal(t, "Price: sdk.NewDec(3),\n", price.GenesisArgs(3))
+	require.Equal(t, "Deadline: time.Unix(3, 0).UTC(),\n", deadline.GenesisArgs(3))
+	require.Equal(t, []datatype.GoImport{
+		{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"},
+		{Name: "time"},
+	}, Fields{title, price, auction, deadline}.GenesisArgsImports())
+	require.Empty(t, Fields{title}.GenesisArgsImports())
+}
+
+func TestSimulationValue(t *testing.T) {
+	owner := Field{Name: mustName(t, "owner"), DatatypeName: datatype.Address}
+	title := Field{Name: mustName(t, "title"), DatatypeName: datatype.String}
+	code := Field{
+		Name:         mustName(t, "code"),
+		DatatypeName: datatype.String,
+		Constraints:  []datatype.FieldConstraint{{Name: datatype.ConstraintRegex, Value: "^[A-Z]{3}$"}},
+	}
+	score := Field{
+		Name:         mustName(t, "score"),
+		DatatypeName: datatype.Uint,
+		Constraints:  []datatype.FieldConstraint{{Name: datatype.ConstraintMin, Value: "500"}},
+	}
+
+	require.Equal(t, "simAccount.Address.String()", owner.SimulationValue())
+	require.Empty(t, title.SimulationValue())
+	require.Equal(t, `"AAA"`, code.SimulationValue())
+	require.Equal(t, "500", score.SimulationValue())
+}
+
+func TestEnumValues(t *testing.T) {
+	fields, err := ParseFields([]string{"status:enum(draft,in-review)", "title"}, noCheck)
+	require.NoError(t, err)
+	status, title := fields[0], fields[1]
+
+	require.Equal(t, []string{"STATUS_DRAFT", "STATUS_IN_REVIEW"}, status.EnumValueNames())
+	require.Equal(t, "Status: types.Status_STATUS_DRAFT,\n", status.GenesisArgs(0))
+	require.Equal(t, "Status: types.Status_STATUS_IN_REVIEW,\n", status.GenesisArgs(3))
+	require.Equal(t, "types.Status(int32(i % len(types.Status_name)))", status.SimulationIndex())
+	require.Equal(t, "strconv.Itoa(i)", title.SimulationIndex())
+}
+
+func TestConstraints(t *testing.T) {
+	fields, err := ParseFields([]string{
+		"title:string{min=3,max=5}",
+		"code:string{regex=^[a-z]{2}[0-9]+$}",
+		"score:int{min=1,max=5}",
+		"amount:uint{min=0}",
+		"tags:array.uint{minlen=1,maxlen=2}",
+	}, noCheck)
+	require.NoError(t, err)
+	title, code, score, amount, tags := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	// Valid values
+	require.Equal(t, "xyz", title.DefaultTestValue())
+	require.Equal(t, `"xyz"`, title.SampleTestValue())
+	require.Equal(t, "aa0", code.DefaultTestValue())
+	require.Equal(t, "5", score.SampleTestValue())
+	require.Equal(t, "111", amount.SampleTestValue())
+	require.Equal(t, "1", tags.DefaultTestValue())
+	require.Equal(t, "[]uint64{1}", tags.SampleTestValue())
+
+	// Invalid values
+	require.Equal(t, []InvalidTestValue{
+		{Constraint: "min", Code: `"aa"`},
+		{Constraint: "max", Code: `"aaaaaa"`},
+	}, title.InvalidTestValues())
+	require.Equal(t, []InvalidTestValue{{Constraint: "regex", Code: `""`}}, code.InvalidTestValues())
+	require.Equal(t, []InvalidTestValue{
+		{Constraint: "min", Code: "0"},
+		{Constraint: "max", Code: "6"},
+	}, score.InvalidTestValues())
+	require.Empty(t, amount.InvalidTestValues())
+	require.Equal(t, []InvalidTestValue{
+		{Constraint: "minlen", Code: "[]uint64{}"},
+		{Constraint: "maxlen", Code: "[]uint64{1, 1, 1}"},
+	}, tags.InvalidTestValues())
+
+	// Validation
+	require.Equal(t, `if utf8.RuneCountInString(msg.Title) < 3 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "title must have at least 3 characters")
+	}
+if utf8.RuneCountInString(msg.Title) > 5 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "title must have at most 5 characters")
+	}`, title.ValidateBasic("post", "msg."))
+	require.Equal(t, []datatype.GoImport{{Name: "unicode/utf8"}}, Fields{title}.GoTypeImports())
+	require.Empty(t, title.ValidationVars("post"))
+	require.Contains(t, code.ValidateBasic("post", "msg."), `!postCodeRe.MatchString(msg.Code)`)
+	require.Equal(t, `var postCodeRe = regexp.MustCompile("^[a-z]{2}[0-9]+$")`, code.ValidationVars("post"))
+	require.Equal(t, `var codeRe = regexp.MustCompile("^[a-z]{2}[0-9]+$")`, code.ValidationVars(""))
+	require.Equal(t, []datatype.GoImport{{Name: "regexp"}}, code.GoTypeImports())
+	require.Equal(t, `if score < 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "score must be greater than or equal to 1")
+	}
+if score > 5 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "score must be less than or equal to 5")
+	}`, score.ValidateConstraints("params", "score"))
+	require.Len(t, fields.Constrained(), 5)
+}
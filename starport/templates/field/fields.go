@@ -2,6 +2,8 @@ package field
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/templates/field/datatype"
@@ -26,6 +28,38 @@ func (f Fields) GoCLIImports() []datatype.GoImport {
 	return allImports
 }
 
+// GoTypeImports return all go imports required by the types of the fields
+func (f Fields) GoTypeImports() []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range f {
+		for _, goImport := range fields.GoTypeImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
+// GenesisArgsImports return all go imports required by the genesis args of the fields
+func (f Fields) GenesisArgsImports() []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range f {
+		for _, goImport := range fields.GenesisArgsImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
 // ProtoImports return all proto imports
 func (f Fields) ProtoImports() []string {
 	allImports := make([]string, 0)
@@ -51,11 +85,11 @@ func (f Fields) String() string {
 	return args
 }
 
-// Custom return a list of custom fields
+// Custom return a list of custom fields and enums, both are defined in their own proto file
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.TypeCustom {
+		if field.IsCustom() || field.IsEnum() {
 			dataType, err := multiformatname.NewName(field.Datatype)
 			if err != nil {
 				panic(err)
@@ -65,3 +99,68 @@ func (f Fields) Custom() []string {
 	}
 	return fields
 }
+
+// jsonTestValue returns a JSON object containing the test values of the fields
+func (f Fields) jsonTestValue() string {
+	values := make([]string, 0, len(f))
+	for _, field := range f {
+		value := field.jsonTestValue()
+		if value == "" {
+			continue
+		}
+		values = append(values, fmt.Sprintf("%s:%s", strconv.Quote(field.Name.Original), value))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(values, ","))
+}
+
+// genesisArgs returns the genesis args of the fields
+func (f Fields) genesisArgs(value int) string {
+	args := ""
+	for _, field := range f {
+		args += field.GenesisArgs(value)
+	}
+	return args
+}
+
+// GenesisArgsSample returns true if the genesis args of one of the fields use the sample package of the app
+func (f Fields) GenesisArgsSample() bool {
+	for _, field := range f {
+		if field.GenesisArgsSample() {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidationVars returns the declarations of the package-level variables used by the validation of the fields
+func (f Fields) ValidationVars(scope string) string {
+	var vars []string
+	for _, field := range f {
+		if v := field.ValidationVars(scope); v != "" {
+			vars = append(vars, v)
+		}
+	}
+	return strings.Join(vars, "\n")
+}
+
+// Enums return the fields declaring an enum
+func (f Fields) Enums() Fields {
+	enums := make(Fields, 0)
+	for _, field := range f {
+		if field.IsEnum() {
+			enums = append(enums, field)
+		}
+	}
+	return enums
+}
+
+// Constrained return the fields declaring constraints
+func (f Fields) Constrained() Fields {
+	constrained := make(Fields, 0)
+	for _, field := range f {
+		if len(field.Constraints) > 0 {
+			constrained = append(constrained, field)
+		}
+	}
+	return constrained
+}
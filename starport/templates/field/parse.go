@@ -9,21 +9,32 @@ import (
 )
 
 // validateField validates the field Name and type, and checks the name is not forbidden by Starport
-func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, error) {
-	fieldSplit := strings.Split(field, datatype.Separator)
-	if len(fieldSplit) > 2 {
-		return multiformatname.Name{}, "", fmt.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
+// the constraints declared with the type are returned with the type name
+func validateField(
+	field string,
+	isForbiddenField func(string) error,
+) (multiformatname.Name, datatype.Name, []datatype.FieldConstraint, error) {
+	// The constraints of the type can contain the separator
+	fieldSplit := strings.SplitN(field, datatype.Separator, 2)
+	if len(fieldSplit) == 2 {
+		typeDeclaration := fieldSplit[1]
+		if i := strings.Index(typeDeclaration, "{"); i >= 0 {
+			typeDeclaration = typeDeclaration[:i]
+		}
+		if strings.Contains(typeDeclaration, datatype.Separator) {
+			return multiformatname.Name{}, "", nil, fmt.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
+		}
 	}
 
 	name, err := multiformatname.NewName(fieldSplit[0])
 	if err != nil {
-		return name, "", err
+		return name, "", nil, err
 
 	}
 
 	// Ensure the field Name is not a Go reserved Name, it would generate an incorrect code
 	if err := isForbiddenField(name.LowerCamel); err != nil {
-		return name, "", fmt.Errorf("%s can't be used as a field Name: %s", name, err.Error())
+		return name, "", nil, fmt.Errorf("%s can't be used as a field Name: %s", name, err.Error())
 	}
 
 	// Check if the object has an explicit type. The default is a string
@@ -32,7 +43,39 @@ func validateField(field string, isForbiddenField func(string) error) (multiform
 	if isTypeSpecified {
 		dataTypeName = datatype.Name(fieldSplit[1])
 	}
-	return name, dataTypeName, nil
+
+	// Enums are declared with parentheses, they can't have constraints
+	if datatype.IsEnum(dataTypeName) {
+		return name, dataTypeName, nil, nil
+	}
+	dataTypeName, constraints, err := datatype.ParseConstraints(dataTypeName)
+	if err != nil {
+		return name, "", nil, fmt.Errorf("invalid field %s: %s", name.Original, err.Error())
+	}
+	return name, dataTypeName, constraints, nil
+}
+
+// validateConstraints checks the constraints are supported by the type and their values are valid
+func validateConstraints(name multiformatname.Name, datatypeName datatype.Name, constraints []datatype.FieldConstraint) error {
+	if len(constraints) == 0 {
+		return nil
+	}
+	dt, ok := datatype.SupportedTypes[datatypeName]
+	if !ok || dt.Validator == nil {
+		return fmt.Errorf("the field %s can't have constraints, the type %s doesn't support constraints", name.Original, datatypeName)
+	}
+	for _, c := range constraints {
+		if !dt.Validator.Supports(c.Name) {
+			return fmt.Errorf("the constraint %s is not supported by the type %s of %s", c.Name, datatypeName, name.Original)
+		}
+	}
+	if err := dt.Validator.Validate(constraints); err != nil {
+		return fmt.Errorf("invalid constraints for %s: %s", name.Original, err.Error())
+	}
+	if _, err := dt.Validator.Valid(constraints); err != nil {
+		return fmt.Errorf("invalid constraints for %s: %s", name.Original, err.Error())
+	}
+	return nil
 }
 
 // ParseFields parses the provided fields, analyses the types
@@ -52,7 +95,7 @@ func ParseFields(
 
 	var parsedFields Fields
 	for _, field := range fields {
-		name, datatypeName, err := validateField(field, isForbiddenField)
+		name, datatypeName, constraints, err := validateField(field, isForbiddenField)
 		if err != nil {
 			return parsedFields, err
 		}
@@ -63,11 +106,42 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
+		// Only the static types can have constraints
+		if err := validateConstraints(name, datatypeName, constraints); err != nil {
+			return parsedFields, err
+		}
+
+		// Check if the type declares an enum, the enum is named after the field
+		if datatypeName == datatype.Enum || datatype.IsEnum(datatypeName) {
+			values, err := parseEnumValues(datatypeName)
+			if err != nil {
+				return parsedFields, fmt.Errorf("invalid enum %s: %s", name.Original, err.Error())
+			}
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				Datatype:     name.UpperCamel,
+				DatatypeName: datatype.Enum,
+				EnumValues:   values,
+			})
+			continue
+		}
+
 		// Check if is a static type
 		if _, ok := datatype.SupportedTypes[datatypeName]; ok {
 			parsedFields = append(parsedFields, Field{
 				Name:         name,
 				DatatypeName: datatypeName,
+				Constraints:  constraints,
+			})
+			continue
+		}
+
+		// Check if the type declares an array of a custom type
+		if customType, ok := datatype.CustomSliceType(datatypeName); ok {
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				Datatype:     customType,
+				DatatypeName: datatype.CustomSlice,
 			})
 			continue
 		}
@@ -80,3 +154,26 @@ func ParseFields(
 	}
 	return parsedFields, nil
 }
+
+// parseEnumValues parses the values of an enum declaration and checks there is no duplicated value
+func parseEnumValues(declaration datatype.Name) ([]multiformatname.Name, error) {
+	values := datatype.EnumValues(declaration)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("the values must be declared with the format %s(value1,value2...)", datatype.Enum)
+	}
+
+	existingValues := make(map[string]struct{})
+	parsedValues := make([]multiformatname.Name, 0, len(values))
+	for _, value := range values {
+		name, err := multiformatname.NewName(value)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := existingValues[name.Snake]; exists {
+			return nil, fmt.Errorf("the value %s is duplicated", value)
+		}
+		existingValues[name.Snake] = struct{}{}
+		parsedValues = append(parsedValues, name)
+	}
+	return parsedValues, nil
+}
@@ -34,6 +34,39 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum"}, noCheck)
+	require.Error(t, err)
+
+	// enum with duplicated values
+	_, err = ParseFields([]string{"foo:enum(bar,baz,bar)"}, noCheck)
+	require.Error(t, err)
+
+	// constraint not supported by the type
+	_, err = ParseFields([]string{"foo:bool{min=1}"}, noCheck)
+	require.Error(t, err)
+	_, err = ParseFields([]string{"foo:array.string{max=1}"}, noCheck)
+	require.Error(t, err)
+
+	// invalid constraint values
+	_, err = ParseFields([]string{"foo:uint{min=-1}"}, noCheck)
+	require.Error(t, err)
+	_, err = ParseFields([]string{"foo:string{min=5,max=3}"}, noCheck)
+	require.Error(t, err)
+	_, err = ParseFields([]string{"foo:string{regex=[a-z}"}, noCheck)
+	require.Error(t, err)
+
+	// duplicated constraint
+	_, err = ParseFields([]string{"foo:int{min=1,min=2}"}, noCheck)
+	require.Error(t, err)
+}
+
+func mustName(t *testing.T, name string) multiformatname.Name {
+	t.Helper()
+	mfName, err := multiformatname.NewName(name)
+	require.NoError(t, err)
+	return mfName
 }
 
 func TestParseFields1(t *testing.T) {
@@ -181,6 +214,127 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test custom type arrays",
+			fields: []string{
+				name1.Original + ":LineItem",
+				name2.Original + ":array.LineItem",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Custom,
+					Datatype:     "LineItem",
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.CustomSlice,
+					Datatype:     "LineItem",
+				},
+			},
+		},
+		{
+			name: "test constraints",
+			fields: []string{
+				name1.Original + ":string{min=3,max=64}",
+				name2.Original + ":uint{max=1000000}",
+				name3.Original + ":string{regex=^[a-z]{2,4}:[0-9]+$}",
+				name4.Original + ":array.string{maxlen=10}",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.String,
+					Constraints: []datatype.FieldConstraint{
+						{Name: datatype.ConstraintMin, Value: "3"},
+						{Name: datatype.ConstraintMax, Value: "64"},
+					},
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Uint,
+					Constraints: []datatype.FieldConstraint{
+						{Name: datatype.ConstraintMax, Value: "1000000"},
+					},
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.String,
+					Constraints: []datatype.FieldConstraint{
+						{Name: datatype.ConstraintRegex, Value: "^[a-z]{2,4}:[0-9]+$"},
+					},
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.StringSlice,
+					Constraints: []datatype.FieldConstraint{
+						{Name: datatype.ConstraintMaxLen, Value: "10"},
+					},
+				},
+			},
+		},
+		{
+			name: "test scalar types",
+			fields: []string{
+				name1.Original + ":address",
+				name2.Original + ":decimal",
+				name3.Original + ":timestamp",
+				name4.Original + ":bytes",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Address,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Decimal,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Timestamp,
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.Bytes,
+				},
+			},
+		},
+		{
+			name: "test enum types",
+			fields: []string{
+				name2.Original + ":enum(pending,in-progress,done)",
+			},
+			want: Fields{
+				{
+					Name:         name2,
+					DatatypeName: datatype.Enum,
+					Datatype:     name2.UpperCamel,
+					EnumValues: []multiformatname.Name{
+						mustName(t, "pending"),
+						mustName(t, "in-progress"),
+						mustName(t, "done"),
+					},
+				},
+			},
+		},
+		{
+			name: "test sized integer aliases",
+			fields: []string{
+				name1.Original + ":int32",
+				name2.Original + ":uint64",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Int32Alias,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Uint64Alias,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"embed"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/gobuffalo/genny"
@@ -18,10 +19,87 @@ import (
 )
 
 var (
-	//go:embed oracle/* oracle/**/*
-	fsOracle embed.FS
+	//go:embed oracle/common/* oracle/common/**/*
+	fsOracleCommon embed.FS
 )
 
+// OracleProvider describes how the oracle queries of a module are requested to an oracle chain or module and how
+// the packets it sends back are handled. The results of the queries are stored and queried the same way whatever
+// the provider.
+type OracleProvider struct {
+	// Name is the name of the provider.
+	Name string
+
+	// Description describes the chain or the module answering the queries.
+	Description string
+
+	// Dependencies are the Go packages imported by the templates of the provider with their version.
+	Dependencies []string
+
+	// Templates are the templates of the provider under TemplatesDir: the proto of the query and of its packets,
+	// the message server encoding and sending the request packets, the message requesting the query and the
+	// handlers of the received packets and acknowledgments.
+	Templates    embed.FS
+	TemplatesDir string
+
+	// HandlerFile is the path of the file of the handlers of the packets relative to the module.
+	HandlerFile string
+
+	// MsgFields are the proto fields of the message requesting the query following the signer field,
+	// %[1]v is the name of the query in upper camel case.
+	MsgFields string
+
+	// ModuleRecv and ModuleAck dispatch the received packets and the acknowledgments of the module to the
+	// handlers of the provider, %[1]v is the placeholder the snippet is inserted before.
+	ModuleRecv string
+	ModuleAck  string
+
+	// Recv and Ack are the cases of the handlers handling the packets of the query, %[1]v is the placeholder
+	// the snippet is inserted before, %[2]v and %[3]v are the name of the query in lower and upper camel case.
+	Recv string
+	Ack  string
+}
+
+// OracleProviderBand is the name of the default provider requesting BandChain oracle scripts
+const OracleProviderBand = "band"
+
+var oracleProviders = map[string]OracleProvider{
+	OracleProviderBand:   bandOracleProvider,
+	OracleProviderCustom: customOracleProvider,
+}
+
+// RegisterOracleProvider registers a provider for the oracle queries
+func RegisterOracleProvider(provider OracleProvider) error {
+	if _, ok := oracleProviders[provider.Name]; ok {
+		return fmt.Errorf("oracle provider %s is already registered", provider.Name)
+	}
+	oracleProviders[provider.Name] = provider
+	return nil
+}
+
+// GetOracleProvider returns the registered oracle provider with the name
+func GetOracleProvider(name string) (OracleProvider, error) {
+	provider, ok := oracleProviders[name]
+	if !ok {
+		return provider, fmt.Errorf(
+			"unknown oracle provider %s, the providers are: %s",
+			name,
+			strings.Join(OracleProviderNames(), ", "),
+		)
+	}
+	return provider, nil
+}
+
+// OracleProviderNames returns the sorted names of the registered oracle providers
+func OracleProviderNames() []string {
+	names := make([]string, 0, len(oracleProviders))
+	for name := range oracleProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // OracleOptions are options to scaffold an oracle query in a IBC module
 type OracleOptions struct {
 	AppName    string
@@ -31,13 +109,21 @@ type OracleOptions struct {
 	OwnerName  string
 	QueryName  multiformatname.Name
 	MsgSigner  multiformatname.Name
+	Provider   OracleProvider
 }
 
 // NewOracle returns the generator to scaffold the implementation of the Oracle interface inside a module
 func NewOracle(clip *clipper.Clipper, opts *OracleOptions) (*genny.Generator, error) {
 	g := genny.New()
 
-	template := xgenny.NewEmbedWalker(fsOracle, "oracle/", opts.AppPath)
+	var (
+		commonTemplate   = xgenny.NewEmbedWalker(fsOracleCommon, "oracle/common/", opts.AppPath)
+		providerTemplate = xgenny.NewEmbedWalker(
+			opts.Provider.Templates,
+			opts.Provider.TemplatesDir+"/",
+			opts.AppPath,
+		)
+	)
 
 	g.RunFn(moduleOracleModify(clip, opts))
 	g.RunFn(protoQueryOracleModify(clip, opts))
@@ -68,7 +154,10 @@ func NewOracle(clip *clipper.Clipper, opts *OracleOptions) (*genny.Generator, er
 		return g, err
 	}
 
-	if err := xgenny.Box(g, template); err != nil {
+	if err := xgenny.Box(g, commonTemplate); err != nil {
+		return g, err
+	}
+	if err := xgenny.Box(g, providerTemplate); err != nil {
 		return g, err
 	}
 
@@ -86,27 +175,11 @@ func moduleOracleModify(clip *clipper.Clipper, opts *OracleOptions) genny.RunFn
 		}
 
 		// Recv packet dispatch
-		templateRecv := `oracleAck, err := am.handleOraclePacket(ctx, modulePacket)
-	if err != nil {
-		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: "+err.Error()).Error())
-	} else if ack != oracleAck {
-		return oracleAck
-	}
-	%[1]v`
-		replacementRecv := fmt.Sprintf(templateRecv, PlaceholderOraclePacketModuleRecv)
+		replacementRecv := fmt.Sprintf(opts.Provider.ModuleRecv, PlaceholderOraclePacketModuleRecv)
 		content := clip.ReplaceOnce(f.String(), PlaceholderOraclePacketModuleRecv, replacementRecv)
 
 		// Ack packet dispatch
-		templateAck := `sdkResult, err := am.handleOracleAcknowledgment(ctx, ack, modulePacket)
-	if err != nil {
-		return nil, err
-	}
-	if sdkResult != nil {
-		sdkResult.Events = ctx.EventManager().Events().ToABCIEvents()
-		return sdkResult, nil
-	}
-	%[1]v`
-		replacementAck := fmt.Sprintf(templateAck, PlaceholderOraclePacketModuleAck)
+		replacementAck := fmt.Sprintf(opts.Provider.ModuleAck, PlaceholderOraclePacketModuleAck)
 		content = clip.ReplaceOnce(content, PlaceholderOraclePacketModuleAck, replacementAck)
 
 		newFile := genny.NewFileS(path, content)
@@ -249,28 +322,14 @@ import "%[1]v/%[2]v.proto";`
 
 message Msg%[1]vData {
   string %[2]v = 1;
-  uint64 oracle_script_id = 2 [
-    (gogoproto.customname) = "OracleScriptID",
-    (gogoproto.moretags) = "yaml:\"oracle_script_id\""
-  ];
-  string source_channel = 3;
-  %[1]vCallData calldata = 4;
-  uint64 ask_count = 5;
-  uint64 min_count = 6;
-  repeated cosmos.base.v1beta1.Coin fee_limit = 7 [
-    (gogoproto.nullable) = false,
-    (gogoproto.castrepeated) = "github.com/cosmos/cosmos-sdk/types.Coins"
-  ];
-  uint64 prepare_gas = 8;
-  uint64 execute_gas = 9;
-  string client_id = 10 [(gogoproto.customname) = "ClientID"];
-}
+%[3]v}
 
 message Msg%[1]vDataResponse {
 }`
 		messagesSnippet := fmt.Sprintf(templateMessage,
 			opts.QueryName.UpperCamel,
 			opts.MsgSigner.LowerCamel,
+			fmt.Sprintf(opts.Provider.MsgFields, opts.QueryName.UpperCamel),
 		)
 		content, err = clip.PasteCodeSnippetAt(
 			path,
@@ -445,43 +504,21 @@ func codecOracleModify(clip *clipper.Clipper, opts *OracleOptions) genny.RunFn {
 
 func packetHandlerOracleModify(clip *clipper.Clipper, opts *OracleOptions) genny.RunFn {
 	return func(r *genny.Runner) error {
-		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "oracle.go")
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, opts.Provider.HandlerFile)
 		f, err := r.Disk.Find(path)
 		if err != nil {
 			return err
 		}
 
-		// Register the module packet
-		templateRecv := `
-	case types.%[3]vClientIDKey:
-		var %[2]vResult types.%[3]vResult
-		if err := obi.Decode(modulePacketData.Result, &%[2]vResult); err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err.Error())
-			return ack, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
-				"cannot decode the %[2]v received packet")
-		}
-		am.keeper.Set%[3]vResult(ctx, types.OracleRequestID(modulePacketData.RequestID), %[2]vResult)
-	
-		// TODO: %[3]v oracle data reception logic
-%[1]v`
-		replacementRegistry := fmt.Sprintf(templateRecv, PlaceholderOracleModuleRecv,
+		// Handle the received packet of the query
+		replacementRecv := fmt.Sprintf(opts.Provider.Recv, PlaceholderOracleModuleRecv,
 			opts.QueryName.LowerCamel, opts.QueryName.UpperCamel)
-		content := clip.Replace(f.String(), PlaceholderOracleModuleRecv, replacementRegistry)
+		content := clip.Replace(f.String(), PlaceholderOracleModuleRecv, replacementRecv)
 
-		// Register the module packet interface
-		templateAck := `
-	case types.%[3]vClientIDKey:
-		var %[2]vData types.%[3]vCallData
-		if err = obi.Decode(data.GetCalldata(), &%[2]vData); err != nil {
-			return nil, sdkerrors.Wrap(err,
-				"cannot decode the %[2]v oracle acknowledgment packet")
-		}
-		am.keeper.SetLast%[3]vID(ctx, requestID)
-		return &sdk.Result{}, nil
-%[1]v`
-		replacementInterface := fmt.Sprintf(templateAck, PlaceholderOracleModuleAck,
+		// Handle the acknowledgment of the query request
+		replacementAck := fmt.Sprintf(opts.Provider.Ack, PlaceholderOracleModuleAck,
 			opts.QueryName.LowerCamel, opts.QueryName.UpperCamel)
-		content = clip.Replace(content, PlaceholderOracleModuleAck, replacementInterface)
+		content = clip.Replace(content, PlaceholderOracleModuleAck, replacementAck)
 
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
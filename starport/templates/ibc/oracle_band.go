@@ -0,0 +1,72 @@
+package ibc
+
+import "embed"
+
+var (
+	//go:embed oracle/band/* oracle/band/**/*
+	fsOracleBand embed.FS
+)
+
+// bandOracleProvider requests BandChain oracle scripts with the packets of bandchain-packet encoded with OBI
+var bandOracleProvider = OracleProvider{
+	Name:         OracleProviderBand,
+	Description:  "BandChain oracle scripts",
+	Dependencies: []string{"github.com/bandprotocol/bandchain-packet@v0.0.2"},
+	Templates:    fsOracleBand,
+	TemplatesDir: "oracle/band",
+	HandlerFile:  "oracle.go",
+	MsgFields: `  uint64 oracle_script_id = 2 [
+    (gogoproto.customname) = "OracleScriptID",
+    (gogoproto.moretags) = "yaml:\"oracle_script_id\""
+  ];
+  string source_channel = 3;
+  %[1]vCallData calldata = 4;
+  uint64 ask_count = 5;
+  uint64 min_count = 6;
+  repeated cosmos.base.v1beta1.Coin fee_limit = 7 [
+    (gogoproto.nullable) = false,
+    (gogoproto.castrepeated) = "github.com/cosmos/cosmos-sdk/types.Coins"
+  ];
+  uint64 prepare_gas = 8;
+  uint64 execute_gas = 9;
+  string client_id = 10 [(gogoproto.customname) = "ClientID"];
+`,
+	ModuleRecv: `oracleAck, err := am.handleOraclePacket(ctx, modulePacket)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: "+err.Error()).Error())
+	} else if ack != oracleAck {
+		return oracleAck
+	}
+	%[1]v`,
+	ModuleAck: `sdkResult, err := am.handleOracleAcknowledgment(ctx, ack, modulePacket)
+	if err != nil {
+		return nil, err
+	}
+	if sdkResult != nil {
+		sdkResult.Events = ctx.EventManager().Events().ToABCIEvents()
+		return sdkResult, nil
+	}
+	%[1]v`,
+	Recv: `
+	case types.%[3]vClientIDKey:
+		var %[2]vResult types.%[3]vResult
+		if err := obi.Decode(modulePacketData.Result, &%[2]vResult); err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err.Error())
+			return ack, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				"cannot decode the %[2]v received packet")
+		}
+		am.keeper.Set%[3]vResult(ctx, types.OracleRequestID(modulePacketData.RequestID), %[2]vResult)
+	
+		// TODO: %[3]v oracle data reception logic
+%[1]v`,
+	Ack: `
+	case types.%[3]vClientIDKey:
+		var %[2]vData types.%[3]vCallData
+		if err = obi.Decode(data.GetCalldata(), &%[2]vData); err != nil {
+			return nil, sdkerrors.Wrap(err,
+				"cannot decode the %[2]v oracle acknowledgment packet")
+		}
+		am.keeper.SetLast%[3]vID(ctx, requestID)
+		return &sdk.Result{}, nil
+%[1]v`,
+}
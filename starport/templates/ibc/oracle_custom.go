@@ -0,0 +1,64 @@
+package ibc
+
+import "embed"
+
+var (
+	//go:embed oracle/custom/* oracle/custom/**/*
+	fsOracleCustom embed.FS
+)
+
+// OracleProviderCustom is the name of the provider requesting a custom oracle module implementing the packets
+// of CustomOraclePacketData encoded in JSON
+const OracleProviderCustom = "custom"
+
+// customOracleProvider requests a custom oracle module with a CustomOracleRequest packet. The oracle module
+// acknowledges the request with the id of the request and sends the result in a CustomOracleResponse packet.
+var customOracleProvider = OracleProvider{
+	Name:         OracleProviderCustom,
+	Description:  "custom oracle module answering the packets of CustomOraclePacketData",
+	Templates:    fsOracleCustom,
+	TemplatesDir: "oracle/custom",
+	HandlerFile:  "oracle_custom.go",
+	MsgFields: `  string source_channel = 2;
+  %[1]vCallData calldata = 3;
+  string client_id = 4 [(gogoproto.customname) = "ClientID"];
+`,
+	ModuleRecv: `customOracleAck, err := am.handleCustomOraclePacket(ctx, modulePacket)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: "+err.Error()).Error())
+	} else if ack != customOracleAck {
+		return customOracleAck
+	}
+	%[1]v`,
+	ModuleAck: `customOracleResult, err := am.handleCustomOracleAcknowledgment(ctx, ack, modulePacket)
+	if err != nil {
+		return nil, err
+	}
+	if customOracleResult != nil {
+		customOracleResult.Events = ctx.EventManager().Events().ToABCIEvents()
+		return customOracleResult, nil
+	}
+	%[1]v`,
+	Recv: `
+	case types.%[3]vClientIDKey:
+		var %[2]vResult types.%[3]vResult
+		if err := types.ModuleCdc.UnmarshalJSON(response.Result, &%[2]vResult); err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err.Error())
+			return ack, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				"cannot decode the %[2]v received packet")
+		}
+		am.keeper.Set%[3]vResult(ctx, types.OracleRequestID(response.RequestID), %[2]vResult)
+
+		// TODO: %[3]v oracle data reception logic
+%[1]v`,
+	Ack: `
+	case types.%[3]vClientIDKey:
+		var %[2]vData types.%[3]vCallData
+		if err := types.ModuleCdc.UnmarshalJSON(request.Calldata, &%[2]vData); err != nil {
+			return nil, sdkerrors.Wrap(err,
+				"cannot decode the %[2]v oracle acknowledgment packet")
+		}
+		am.keeper.SetLast%[3]vID(ctx, requestID)
+		return &sdk.Result{}, nil
+%[1]v`,
+}
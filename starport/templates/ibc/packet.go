@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/plush"
@@ -12,6 +13,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/clipper"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
 	"github.com/tendermint/starport/starport/templates/field"
 	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
 	"github.com/tendermint/starport/starport/templates/module"
@@ -24,6 +26,9 @@ var (
 
 	//go:embed packet/messages/* packet/messages/**/*
 	fsPacketMessages embed.FS
+
+	//go:embed packet/retry/* packet/retry/**/*
+	fsPacketRetry embed.FS
 )
 
 // PacketOptions are options to scaffold a packet in a IBC module
@@ -38,6 +43,23 @@ type PacketOptions struct {
 	Fields     field.Fields
 	AckFields  field.Fields
 	NoMessage  bool
+
+	// TimeoutHeight is the default timeout height of the packet relative to the counterparty chain
+	// the timeout height is disabled if it is 0
+	TimeoutHeight uint64
+
+	// TimeoutDuration is the default timeout of the packet relative to the time of the counterparty chain
+	// the timeout duration is disabled if it is 0
+	TimeoutDuration time.Duration
+
+	// MaxRetries is the maximum number of times a timed out packet is re-sent
+	// the packet is not re-sent if it is 0
+	MaxRetries uint64
+}
+
+// HasRetry returns true if the timed out packets are re-sent
+func (opts *PacketOptions) HasRetry() bool {
+	return opts.MaxRetries > 0
 }
 
 // NewPacket returns the generator to scaffold a packet in an IBC module
@@ -65,11 +87,19 @@ func NewPacket(clip *clipper.Clipper, opts *PacketOptions) (*genny.Generator, er
 		return g, err
 	}
 
+	// Add the pending packet store of the module to re-send the timed out packets
+	if opts.HasRetry() {
+		if err := registerPendingPacket(clip, opts, g); err != nil {
+			return g, err
+		}
+	}
+
 	// Add the send message
 	if !opts.NoMessage {
 		g.RunFn(protoTxModify(clip, opts))
 		g.RunFn(handlerTxModify(clip, opts))
 		g.RunFn(clientCliTxModify(clip, opts))
+		g.RunFn(clientCliTxFlagModify(clip, opts))
 		g.RunFn(codecModify(clip, opts))
 		if err := g.Box(messagesTemplate); err != nil {
 			return g, err
@@ -85,6 +115,12 @@ func NewPacket(clip *clipper.Clipper, opts *PacketOptions) (*genny.Generator, er
 	ctx.Set("ownerName", opts.OwnerName)
 	ctx.Set("fields", opts.Fields)
 	ctx.Set("ackFields", opts.AckFields)
+	ctx.Set("timeoutHeight", opts.TimeoutHeight)
+	ctx.Set("timeoutTimestamp", opts.TimeoutDuration.Nanoseconds())
+	ctx.Set("timeoutDuration", opts.TimeoutDuration.String())
+	ctx.Set("retry", opts.HasRetry())
+	ctx.Set("maxRetries", opts.MaxRetries)
+	ctx.Set("formatOwnerName", xstrings.FormatUsername)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
@@ -347,7 +383,7 @@ func protoTxModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
 		}
 		var sendFields string
 		for i, fld := range opts.Fields {
-			sendFields += fmt.Sprintf("  %s;\n", fld.ProtoType(i+5))
+			sendFields += fmt.Sprintf("  %s;\n", fld.ProtoType(i+7))
 		}
 
 		// Ensure custom types are imported
@@ -369,9 +405,8 @@ import "%[1]v";`, f)
 		}
 
 		// Message
-		// TODO: Include timestamp height
-		// This addition would include using the type ibc.core.client.v1.Height
-		// Ex: https://github.com/cosmos/cosmos-sdk/blob/816306b85addae6350bd380997f2f4bf9dce9471/proto/ibc/applications/transfer/v1/tx.proto
+		// The timeout height is split in its revision number and its revision height because
+		// the proto files of ibc-go that define the type ibc.core.client.v1.Height aren't included
 		templateMessage := `
 
 message MsgSend%[1]v {
@@ -379,6 +414,8 @@ message MsgSend%[1]v {
   string port = 2;
   string channelID = 3;
   uint64 timeoutTimestamp = 4;
+  uint64 timeoutRevisionNumber = 5;
+  uint64 timeoutRevisionHeight = 6;
 %[3]v}
 
 message MsgSend%[1]vResponse {
@@ -539,3 +576,29 @@ func codecModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
 		return r.File(newFile)
 	}
 }
+
+func clientCliTxFlagModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/tx.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Older modules don't define the timeout height flag
+		content := f.String()
+		if strings.Contains(content, "flagPacketTimeoutHeight") {
+			return nil
+		}
+
+		snippet := `
+const flagPacketTimeoutHeight = "packet-timeout-height"`
+		content, err = clip.PasteCodeSnippetAt(path, content, clipper.GoSelectNewGlobalPosition, nil, snippet)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
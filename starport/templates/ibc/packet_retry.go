@@ -0,0 +1,181 @@
+package ibc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/blocker"
+)
+
+// registerPendingPacket adds the pending packet store of the module if it doesn't exist yet
+// the timed out pending packets are re-sent from the EndBlock method of the module and the
+// pending packets are exported with the genesis of the module
+func registerPendingPacket(clip *clipper.Clipper, opts *PacketOptions, g *genny.Generator) error {
+	keeperPath := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper", "pending_packet.go")
+	if _, err := os.Stat(keeperPath); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	blockerName, err := multiformatname.NewName("retryPendingPackets")
+	if err != nil {
+		return err
+	}
+
+	g.RunFn(protoQueryPendingPacketModify(clip, opts))
+	g.RunFn(clientCliQueryPendingPacketModify(clip, opts))
+	g.RunFn(moduleGRPCGatewayModify(clip, opts))
+	pendingPacketGenesisModify(clip, opts, g)
+	blockerOpts := &blocker.Options{
+		AppPath:     opts.AppPath,
+		ModuleName:  opts.ModuleName,
+		Hook:        blocker.HookEndBlock,
+		BlockerName: blockerName,
+	}
+	g.RunFn(blocker.ModuleModify(clip, blockerOpts))
+	g.RunFn(blocker.AppModify(clip, blockerOpts))
+
+	return g.Box(xgenny.NewEmbedWalker(fsPacketRetry, "packet/retry/", opts.AppPath))
+}
+
+func protoQueryPendingPacketModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		content := f.String()
+		for _, protoImport := range []string{
+			"gogoproto/gogo.proto",
+			"cosmos/base/query/v1beta1/pagination.proto",
+			fmt.Sprintf("%s/pending_packet.proto", opts.ModuleName),
+		} {
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, protoImport)
+			content = strings.ReplaceAll(content, importModule, "")
+
+			content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+			if err != nil {
+				return err
+			}
+		}
+
+		// RPC service
+		templateRPC := `
+  // Queries the list of the packets waiting for their acknowledgement.
+	rpc PendingPacketAll(QueryAllPendingPacketRequest) returns (QueryAllPendingPacketResponse) {
+		option (google.api.http).get = "/%[1]v/%[2]v/%[3]v/pending_packet";
+	}
+`
+		serviceSnippet := fmt.Sprintf(templateRPC,
+			opts.OwnerName,
+			opts.AppName,
+			opts.ModuleName,
+		)
+
+		if strings.Count(content, Placeholder2) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			serviceSnippet += Placeholder2
+			content = clip.Replace(content, Placeholder2, serviceSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.ProtoSelectNewServiceMethodPosition,
+				clipper.SelectOptions{
+					"name": "Query",
+				},
+				serviceSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Messages
+		templateMessages := `
+
+message QueryAllPendingPacketRequest {
+	cosmos.base.query.v1beta1.PageRequest pagination = 1;
+}
+
+message QueryAllPendingPacketResponse {
+	repeated PendingPacket pendingPacket = 1 [(gogoproto.nullable) = false];
+	cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}`
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectLastPosition,
+			nil,
+			templateMessages,
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliQueryPendingPacketModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		snippet := `cmd.AddCommand(CmdListPendingPacket())`
+
+		if strings.Count(content, Placeholder) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			snippet += "\n" + Placeholder
+			content = clip.Replace(content, Placeholder, snippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, snippet, clipper.SelectOptions{
+				"functionName": "GetQueryCmd",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func moduleGRPCGatewayModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		snippet := `"context"`
+		content, err := clip.PasteGoImportSnippetAt(path, f.String(), snippet)
+		if err != nil {
+			return err
+		}
+
+		snippet = `types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))`
+		content = clip.ReplaceOnce(content, Placeholder2, snippet)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,319 @@
+package ibc
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/templates/module"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+// pendingPacketGenesisModify adds the pending packets to the genesis of the module
+func pendingPacketGenesisModify(clip *clipper.Clipper, opts *PacketOptions, g *genny.Generator) {
+	g.RunFn(pendingPacketGenesisProtoModify(clip, opts))
+	g.RunFn(pendingPacketGenesisTypesModify(clip, opts))
+	g.RunFn(pendingPacketGenesisModuleModify(clip, opts))
+	g.RunFn(pendingPacketGenesisTestsModify(clip, opts))
+	g.RunFn(pendingPacketGenesisTypesTestsModify(clip, opts))
+}
+
+func pendingPacketGenesisProtoModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "genesis.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := strings.ReplaceAll(f.String(), `
+import "gogoproto/gogo.proto";`, "")
+
+		templateProtoImport := `
+import "gogoproto/gogo.proto";
+import "%[1]v/pending_packet.proto";`
+		importString := fmt.Sprintf(templateProtoImport, opts.ModuleName)
+
+		content, err = clip.PasteProtoImportSnippetAt(path, content, importString)
+		if err != nil {
+			return err
+		}
+
+		templateProtoState := `  repeated PendingPacket pendingPacketList = %[1]v [(gogoproto.nullable) = false];
+`
+		content, err = clip.PasteGeneratedCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectNewMessageFieldPosition,
+			clipper.SelectOptions{
+				"name": "GenesisState",
+			},
+			func(data interface{}) string {
+				highestNumber := data.(clipper.ProtoNewMessageFieldPositionData).HighestFieldNumber
+				return fmt.Sprintf(templateProtoState, highestNumber+1)
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func pendingPacketGenesisTypesModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// There can be duplicate imports of `"fmt"` when the command is run more
+		// times but the gofmt will remove the duplicate ones.
+		importSnippet := `"fmt"`
+		content, err := clip.PasteGoImportSnippetAt(path, f.String(), importSnippet)
+		if err != nil {
+			return err
+		}
+
+		funcArgSnippet := `PendingPacketList: []PendingPacket{}`
+
+		if strings.Count(content, typed.PlaceholderGenesisTypesDefault) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			funcArgSnippet += ",\n" + typed.PlaceholderGenesisTypesDefault
+			content = clip.Replace(content, typed.PlaceholderGenesisTypesDefault, funcArgSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+				path,
+				content,
+				funcArgSnippet,
+				clipper.SelectOptions{
+					"functionName": "DefaultGenesis",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		beforeReturnSnippet := `// Check for duplicated pending packets
+	pendingPacketKeyMap := make(map[string]struct{})
+	for _, elem := range gs.PendingPacketList {
+		key := string(PendingPacketKey(elem.Port, elem.ChannelID, elem.Sequence))
+		if _, ok := pendingPacketKeyMap[key]; ok {
+			return fmt.Errorf("duplicated pending packet for port %s, channel %s and sequence %d", elem.Port, elem.ChannelID, elem.Sequence)
+		}
+		pendingPacketKeyMap[key] = struct{}{}
+	}`
+
+		if strings.Count(content, typed.PlaceholderGenesisTypesValidate) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			beforeReturnSnippet += "\n" + typed.PlaceholderGenesisTypesValidate
+			content = clip.Replace(content, typed.PlaceholderGenesisTypesValidate, beforeReturnSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, beforeReturnSnippet, clipper.SelectOptions{
+				"functionName": "Validate",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func pendingPacketGenesisModuleModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+
+		moduleInitSnippet := `
+	// Set all the pending packets
+	for _, elem := range genState.PendingPacketList {
+		k.SetPendingPacket(ctx, elem)
+	}`
+
+		if strings.Count(content, typed.PlaceholderGenesisModuleInit) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			moduleInitSnippet += "\n" + typed.PlaceholderGenesisModuleInit
+			content = clip.Replace(content, typed.PlaceholderGenesisModuleInit, moduleInitSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectStartOfFunctionPosition,
+				clipper.SelectOptions{
+					"functionName": "InitGenesis",
+				},
+				moduleInitSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		moduleExport := `genesis.PendingPacketList = k.GetAllPendingPacket(ctx)`
+
+		if strings.Count(content, typed.PlaceholderGenesisModuleExport) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			moduleExport += "\n" + typed.PlaceholderGenesisModuleExport
+			content = clip.Replace(content, typed.PlaceholderGenesisModuleExport, moduleExport)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, moduleExport, clipper.SelectOptions{
+				"functionName": "ExportGenesis",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func pendingPacketGenesisTestsModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		testStateSnippet := `PendingPacketList: []types.PendingPacket{
+		{
+			Port:      types.PortID,
+			ChannelID: "channel-0",
+			Sequence:  1,
+		},
+		{
+			Port:      types.PortID,
+			ChannelID: "channel-0",
+			Sequence:  2,
+			TimedOut:  true,
+		},
+	}`
+
+		if strings.Count(content, module.PlaceholderGenesisTestState) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			testStateSnippet += ",\n" + module.PlaceholderGenesisTestState
+			content = clip.Replace(content, module.PlaceholderGenesisTestState, testStateSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+				path,
+				content,
+				testStateSnippet,
+				clipper.SelectOptions{
+					"functionName": "newTestGenesisState",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		beforeReturnSnippet := `require.ElementsMatch(t, genesisState.PendingPacketList, got.PendingPacketList)`
+
+		if strings.Count(content, module.PlaceholderGenesisTestAssert) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			beforeReturnSnippet += "\n" + module.PlaceholderGenesisTestAssert
+			content = clip.Replace(content, module.PlaceholderGenesisTestAssert, beforeReturnSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, beforeReturnSnippet, clipper.SelectOptions{
+				"functionName": "TestGenesis",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func pendingPacketGenesisTypesTestsModify(clip *clipper.Clipper, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		validFieldSnippet := `PendingPacketList: []types.PendingPacket{
+	{
+		Port:      types.PortID,
+		ChannelID: "channel-0",
+		Sequence:  1,
+	},
+	{
+		Port:      types.PortID,
+		ChannelID: "channel-0",
+		Sequence:  2,
+	},
+}`
+
+		if strings.Count(content, module.PlaceholderTypesGenesisValidField) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			validFieldSnippet += ",\n" + module.PlaceholderTypesGenesisValidField
+			content = clip.Replace(content, module.PlaceholderTypesGenesisValidField, validFieldSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+				path,
+				content,
+				validFieldSnippet,
+				clipper.SelectOptions{
+					"functionName": "newTestGenesisState",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		templateTests := `{
+	desc:     "duplicated pending packet",
+	genState: &types.GenesisState{
+		PendingPacketList: []types.PendingPacket{
+			{
+				Port:      types.PortID,
+				ChannelID: "channel-0",
+				Sequence:  1,
+			},
+			{
+				Port:      types.PortID,
+				ChannelID: "channel-0",
+				Sequence:  1,
+			},
+		},
+	},
+	valid:    false,
+},
+%[1]v`
+		replacementTests := fmt.Sprintf(templateTests, module.PlaceholderTypesGenesisTestcase)
+		content = clip.Replace(content, module.PlaceholderTypesGenesisTestcase, replacementTests)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,35 @@
+package invariant
+
+import (
+	"embed"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+)
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+)
+
+func Box(opts *Options, g *genny.Generator) error {
+	if err := g.Box(xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)); err != nil {
+		return err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("InvariantName", opts.InvariantName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{invariantName}}", opts.InvariantName.Snake))
+
+	return nil
+}
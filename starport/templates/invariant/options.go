@@ -0,0 +1,14 @@
+package invariant
+
+import (
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// Options ...
+type Options struct {
+	AppName       string
+	AppPath       string
+	ModuleName    string
+	ModulePath    string
+	InvariantName multiformatname.Name
+}
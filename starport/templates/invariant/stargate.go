@@ -0,0 +1,73 @@
+package invariant
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// NewStargate returns the generator to scaffold an invariant in a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(ModuleModify(clip, opts.AppPath, opts.ModuleName, opts.InvariantName))
+
+	return g, Box(opts, g)
+}
+
+// ModuleModify registers invariants in the RegisterInvariants method of a module
+// the invariant named "total-supply" is returned by the function keeper.TotalSupplyInvariant and routed as total-supply
+func ModuleModify(clip *clipper.Clipper, appPath, moduleName string, invariants ...multiformatname.Name) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, "x", moduleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		selectOptions := clipper.SelectOptions{
+			"functionName": "RegisterInvariants",
+			"receiverType": "AppModule",
+			"typeName":     "sdk.InvariantRegistry",
+		}
+
+		// Name the registry parameter if it is unused
+		irName := "ir"
+		content, err := clip.ReplaceCodeSnippetsAt(
+			path,
+			f.String(),
+			clipper.GoSelectFunctionParameterNames,
+			selectOptions,
+			func(data interface{}) string {
+				if name := data.(string); name != "_" {
+					irName = name
+				}
+				return irName
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		var routes []string
+		for _, invariant := range invariants {
+			routes = append(routes, fmt.Sprintf(
+				`%[1]v.RegisterRoute(types.ModuleName, "%[2]v", keeper.%[3]vInvariant(am.keeper))`,
+				irName,
+				invariant.Kebab,
+				invariant.UpperCamel,
+			))
+		}
+		content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, strings.Join(routes, "\n\t"), selectOptions)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
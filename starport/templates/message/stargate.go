@@ -21,6 +21,7 @@ func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error)
 	g.RunFn(typesCodecModify(clip, opts))
 	g.RunFn(clientCliTxModify(clip, opts))
 	g.RunFn(moduleSimulationModify(clip, opts))
+	g.RunFn(protoEventsModify(clip, opts))
 
 	template := xgenny.NewEmbedWalker(
 		fsStargate,
@@ -158,6 +159,62 @@ import "%[1]v";`, f)
 	}
 }
 
+func protoEventsModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "events.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var eventFields string
+		for i, field := range opts.Fields {
+			eventFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+2))
+		}
+
+		template := `
+
+message Event%[1]v {
+  string %[3]v = 1;
+%[2]v}`
+		replacement := fmt.Sprintf(template,
+			opts.MsgName.UpperCamel,
+			eventFields,
+			opts.MsgSigner.LowerCamel,
+		)
+		content, err := clip.PasteCodeSnippetAt(
+			path,
+			f.String(),
+			clipper.ProtoSelectLastPosition,
+			nil,
+			replacement,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Ensure custom types are imported
+		protoImports := opts.Fields.ProtoImports()
+		for _, f := range opts.Fields.Custom() {
+			protoImports = append(protoImports,
+				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
+			)
+		}
+		for _, f := range protoImports {
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, f)
+			content = strings.ReplaceAll(content, importModule, "")
+			content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
 func typesCodecModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/codec.go")
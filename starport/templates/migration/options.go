@@ -0,0 +1,29 @@
+package migration
+
+import "fmt"
+
+// Options ...
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+
+	// FromVersion is the consensus version of the module migrated by the migration
+	FromVersion uint64
+}
+
+// ToVersion returns the consensus version of the module after the migration
+func (opts *Options) ToVersion() uint64 {
+	return opts.FromVersion + 1
+}
+
+// VersionPackage returns the name of the package of the migration, e.g. "v3"
+func (opts *Options) VersionPackage() string {
+	return fmt.Sprintf("v%d", opts.ToVersion())
+}
+
+// MethodName returns the name of the migrator method running the migration, e.g. "Migrate2to3"
+func (opts *Options) MethodName() string {
+	return fmt.Sprintf("Migrate%dto%d", opts.FromVersion, opts.ToVersion())
+}
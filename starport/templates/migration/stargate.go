@@ -0,0 +1,86 @@
+package migration
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+)
+
+// NewStargate returns the generator to scaffold a store migration in a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(moduleModify(clip, opts))
+
+	return g, Box(opts, g)
+}
+
+// moduleModify bumps the consensus version of the module and registers the migration in the configurator
+func moduleModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := clip.ReplaceCodeSnippetsAt(
+			path,
+			f.String(),
+			clipper.GoSelectReturnedValues,
+			clipper.SelectOptions{
+				"functionName": "ConsensusVersion",
+				"receiverType": "AppModule",
+			},
+			func(interface{}) string {
+				return fmt.Sprint(opts.ToVersion())
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		selectOptions := clipper.SelectOptions{
+			"functionName": "RegisterServices",
+			"receiverType": "AppModule",
+			"typeName":     "module.Configurator",
+		}
+
+		// Name the configurator parameter if it is unused
+		cfgName := "cfg"
+		content, err = clip.ReplaceCodeSnippetsAt(
+			path,
+			content,
+			clipper.GoSelectFunctionParameterNames,
+			selectOptions,
+			func(data interface{}) string {
+				if name := data.(string); name != "_" {
+					cfgName = name
+				}
+				return cfgName
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		var snippet string
+		if !strings.Contains(content, "keeper.NewMigrator(") {
+			snippet = "migrator := keeper.NewMigrator(am.keeper)\n\t"
+		}
+		template := `if err := %[1]v.RegisterMigration(types.ModuleName, %[2]v, migrator.%[3]v); err != nil {
+		panic(fmt.Errorf("failed to register the migration of %%s to v%[4]v: %%w", types.ModuleName, err))
+	}`
+		snippet += fmt.Sprintf(template, cfgName, opts.FromVersion, opts.MethodName(), opts.ToVersion())
+		content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, snippet, selectOptions)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,32 @@
+package modulecreate
+
+import (
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+)
+
+// AddAdmins returns the generator to generate the keeper method checking if an address is one of the admins
+// of a module, the admins are set in the admins param of the module
+func AddAdmins(appPath, modulePath, moduleName string) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsAdmins, "admins/", appPath)
+	)
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", moduleName)
+	ctx.Set("modulePath", modulePath)
+	plushhelpers.ExtendPlushContext(ctx)
+
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", moduleName))
+
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
@@ -0,0 +1,35 @@
+package modulecreate
+
+import (
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
+)
+
+// AddEvents returns the generator to generate the events.proto file
+func AddEvents(appPath, appName, modulePath, moduleName, ownerName string) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsEvents, "events/", appPath)
+	)
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", moduleName)
+	ctx.Set("modulePath", modulePath)
+	ctx.Set("appName", appName)
+	ctx.Set("ownerName", ownerName)
+
+	// Used for proto package name
+	ctx.Set("formatOwnerName", xstrings.FormatUsername)
+
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", moduleName))
+
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
@@ -0,0 +1,102 @@
+package modulecreate
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+// IBCMiddlewareTransfer is the IBC middleware wrapping the IBC module of the ICS-20 transfer app
+const IBCMiddlewareTransfer = "transfer"
+
+// NewIBCMiddleware returns the generator to scaffold a middleware wrapping the IBC module of an app inside a module
+func NewIBCMiddleware(opts *CreateOptions) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsIBCMiddleware, "ibcmiddleware/", opts.AppPath)
+	)
+
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("ownerName", opts.OwnerName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	return g, nil
+}
+
+// appIBCMiddlewareModify wraps the module of the transfer route of the IBC router with the middleware of the module
+func appIBCMiddlewareModify(clip *clipper.Clipper, opts *CreateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		const routeCall = "AddRoute(ibctransfertypes.ModuleName,"
+		var found bool
+		content, err := clip.ReplaceCodeSnippetsAt(
+			path,
+			f.String(),
+			clipper.GoSelectStatementsReferencing,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"names":        "ibcRouter",
+			},
+			func(data interface{}) string {
+				statement := data.(string)
+				start := strings.Index(statement, routeCall)
+				if start < 0 {
+					return statement
+				}
+				start += len(routeCall)
+
+				// Find the end of the module argument of the route
+				end, depth := start, 0
+				for ; end < len(statement); end++ {
+					if c := statement[end]; c == '(' {
+						depth++
+					} else if c == ')' {
+						if depth == 0 {
+							break
+						}
+						depth--
+					}
+				}
+				if end == len(statement) {
+					return statement
+				}
+				found = true
+
+				ibcModule := strings.TrimRight(strings.TrimSpace(statement[start:end]), ",")
+				template := ` %[1]vmodule.NewTransferMiddleware(%[2]v, app.%[3]vKeeper)`
+				snippet := fmt.Sprintf(template, opts.ModuleName, ibcModule, strings.Title(opts.ModuleName))
+				return statement[:start] + snippet + statement[end:]
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("the transfer route of the IBC router can't be found in %s", path)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,29 @@
+package modulecreate
+
+import (
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+)
+
+// AddKeeperExport returns the generator to generate the file exporting the internals of the keeper of a module
+// to the tests of the keeper
+func AddKeeperExport(appPath, moduleName string) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsKeeperExport, "keeperexport/", appPath)
+	)
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", moduleName)
+
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", moduleName))
+
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
@@ -0,0 +1,29 @@
+package modulecreate
+
+import (
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+)
+
+// AddMigrator returns the generator to generate the migrator handling the store migrations of a module
+func AddMigrator(appPath, modulePath, moduleName string) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsMigrator, "migrator/", appPath)
+	)
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", moduleName)
+	ctx.Set("modulePath", modulePath)
+
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", moduleName))
+
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
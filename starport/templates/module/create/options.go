@@ -22,6 +22,9 @@ type CreateOptions struct {
 	// Channel ordering of the IBC module: ordered, unordered or none
 	IBCOrdering string
 
+	// IBC middleware scaffolded in the module to wrap the IBC module of an app: transfer or none
+	IBCMiddleware string
+
 	// Dependencies of the module
 	Dependencies []Dependency
 }
@@ -35,6 +38,15 @@ type MsgServerOptions struct {
 	OwnerName  string
 }
 
+// ParamsOptions defines options to add the params to a module
+type ParamsOptions struct {
+	ModuleName string
+	ModulePath string
+	AppName    string
+	AppPath    string
+	OwnerName  string
+}
+
 // Validate that options are usable
 func (opts *CreateOptions) Validate() error {
 	return nil
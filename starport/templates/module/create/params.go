@@ -0,0 +1,467 @@
+package modulecreate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+	"github.com/tendermint/starport/starport/templates/module"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+const paramTypesImport = `paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"`
+
+// AddParams returns the generator to generate the params files missing in a module
+// the params subspace is added to the keeper, the genesis and the queries of a module that doesn't define params
+func AddParams(clip *clipper.Clipper, opts *ParamsOptions) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsParams, "params/", opts.AppPath)
+	)
+
+	_, err := os.Stat(filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go"))
+	if os.IsNotExist(err) {
+		g.RunFn(paramsKeeperModify(clip, opts))
+		g.RunFn(paramsAppModify(clip, opts))
+		g.RunFn(paramsTestutilModify(clip, opts))
+		g.RunFn(paramsProtoGenesisModify(clip, opts))
+		g.RunFn(paramsGenesisModify(clip, opts))
+		g.RunFn(paramsTypesGenesisModify(clip, opts))
+		g.RunFn(paramsProtoQueryModify(clip, opts))
+		g.RunFn(paramsClientCliQueryModify(clip, opts))
+	} else if err != nil {
+		return nil, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("ownerName", opts.OwnerName)
+	ctx.Set("params", field.Fields{})
+
+	// Used for proto package name
+	ctx.Set("formatOwnerName", xstrings.FormatUsername)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// paramsKeeperModify adds the params subspace to the keeper of the module
+func paramsKeeperModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if !strings.Contains(content, `"github.com/cosmos/cosmos-sdk/x/params/types"`) {
+			content, err = clip.PasteGoImportSnippetAt(path, content, paramTypesImport)
+			if err != nil {
+				return err
+			}
+		}
+
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectStructNewFieldPosition,
+			clipper.SelectOptions{
+				"structName": "Keeper",
+			},
+			"\tparamstore paramtypes.Subspace\n",
+		)
+		if err != nil {
+			return err
+		}
+
+		selectOptions := clipper.SelectOptions{
+			"functionName": "NewKeeper",
+		}
+		content, err = clip.PasteGoFunctionNewParameterSnippetAt(path, content, "ps paramtypes.Subspace", selectOptions)
+		if err != nil {
+			return err
+		}
+
+		snippet := `
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+`
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectStartOfFunctionPosition,
+			selectOptions,
+			snippet,
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(path, content, "paramstore: ps", selectOptions)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// paramsAppModify creates the params subspace of the module in the app and passes it to the keeper of the module
+func paramsAppModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Keeper definition
+		snippet := fmt.Sprintf("app.GetSubspace(%[1]vmoduletypes.ModuleName)", opts.ModuleName)
+		content, err := clip.PasteGoFunctionCallNewArgumentSnippetAt(
+			path,
+			f.String(),
+			snippet,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"callName":     fmt.Sprintf("%[1]vmodulekeeper.NewKeeper", opts.ModuleName),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		// Param subspace
+		snippet = fmt.Sprintf("paramsKeeper.Subspace(%[1]vmoduletypes.ModuleName)", opts.ModuleName)
+		if strings.Count(content, module.PlaceholderSgAppParamSubspace) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			snippet += "\n" + module.PlaceholderSgAppParamSubspace
+			content = clip.Replace(content, module.PlaceholderSgAppParamSubspace, snippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, snippet, clipper.SelectOptions{
+				"functionName": "initParamsKeeper",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// paramsTestutilModify passes a params subspace to the keeper of the module created for the tests
+// the modification is skipped if the module has no test keeper
+func paramsTestutilModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "testutil/keeper", opts.ModuleName+".go")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil
+		}
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if !strings.Contains(content, `"github.com/cosmos/cosmos-sdk/x/params/types"`) {
+			content, err = clip.PasteGoImportSnippetAt(path, content, `typesparams "github.com/cosmos/cosmos-sdk/x/params/types"`)
+			if err != nil {
+				return err
+			}
+		}
+
+		selectOptions := clipper.SelectOptions{
+			"functionName": fmt.Sprintf("%[1]vKeeper", strings.Title(opts.ModuleName)),
+		}
+		snippet := fmt.Sprintf(`typesparams.NewSubspace(cdc,
+		codec.NewLegacyAmino(),
+		storeKey,
+		memStoreKey,
+		"%[1]vParams",
+	)`, strings.Title(opts.ModuleName))
+		content, err = clip.PasteGoFunctionCallNewArgumentSnippetAt(
+			path,
+			content,
+			snippet,
+			clipper.SelectOptions{
+				"functionName": selectOptions["functionName"],
+				"callName":     "keeper.NewKeeper",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		// Initialize params
+		content, err = clip.PasteGoBeforeReturnSnippetAt(
+			path,
+			content,
+			"k.SetParams(ctx, types.DefaultParams())\n",
+			selectOptions,
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// paramsProtoGenesisModify adds the params to the genesis state of the module
+func paramsProtoGenesisModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "genesis.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := pasteProtoImports(clip, path, f.String(),
+			"gogoproto/gogo.proto",
+			fmt.Sprintf("%[1]v/params.proto", opts.ModuleName),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = clip.PasteGeneratedCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectNewMessageFieldPosition,
+			clipper.SelectOptions{
+				"name": "GenesisState",
+			},
+			func(data interface{}) string {
+				highestNumber := data.(clipper.ProtoNewMessageFieldPositionData).HighestFieldNumber
+				return fmt.Sprintf("  Params params = %d [(gogoproto.nullable) = false];\n", highestNumber+1)
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// paramsGenesisModify initializes and exports the params with the genesis of the module
+func paramsGenesisModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := clip.PasteGoBeforeReturnSnippetAt(
+			path,
+			f.String(),
+			"k.SetParams(ctx, genState.Params)",
+			clipper.SelectOptions{
+				"functionName": "InitGenesis",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = clip.PasteGoBeforeReturnSnippetAt(
+			path,
+			content,
+			"genesis.Params = k.GetParams(ctx)\n",
+			clipper.SelectOptions{
+				"functionName": "ExportGenesis",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// paramsTypesGenesisModify sets the default params in the default genesis and validates them with the genesis
+func paramsTypesGenesisModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+			path,
+			f.String(),
+			"Params: DefaultParams()",
+			clipper.SelectOptions{
+				"functionName": "DefaultGenesis",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		snippet := `if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+`
+		content, err = clip.PasteGoBeforeReturnSnippetAt(
+			path,
+			content,
+			snippet,
+			clipper.SelectOptions{
+				"functionName": "Validate",
+				"receiverType": "GenesisState",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// paramsProtoQueryModify adds the query of the params to the query service of the module
+func paramsProtoQueryModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := pasteProtoImports(clip, path, f.String(),
+			"gogoproto/gogo.proto",
+			"google/api/annotations.proto",
+			fmt.Sprintf("%[1]v/params.proto", opts.ModuleName),
+		)
+		if err != nil {
+			return err
+		}
+
+		template := `  // Parameters queries the parameters of the module.
+  rpc Params(QueryParamsRequest) returns (QueryParamsResponse) {
+    option (google.api.http).get = "/%[1]v/%[2]v/%[3]v/params";
+  }
+`
+		snippet := fmt.Sprintf(template,
+			xstrings.FormatUsername(opts.OwnerName),
+			opts.AppName,
+			opts.ModuleName,
+		)
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectNewServiceMethodPosition,
+			clipper.SelectOptions{
+				"name": "Query",
+			},
+			snippet,
+		)
+		if err != nil {
+			return err
+		}
+
+		snippet = `
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+message QueryParamsRequest {}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+message QueryParamsResponse {
+  // params holds all the parameters of this module.
+  Params params = 1 [(gogoproto.nullable) = false];
+}
+`
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectLastPosition,
+			nil,
+			snippet,
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// paramsClientCliQueryModify adds the command querying the params to the query commands of the module
+func paramsClientCliQueryModify(clip *clipper.Clipper, opts *ParamsOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		snippet := "cmd.AddCommand(CmdQueryParams())"
+		if strings.Count(content, typed.Placeholder) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			snippet += "\n" + typed.Placeholder
+			content = clip.Replace(content, typed.Placeholder, snippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, snippet, clipper.SelectOptions{
+				"functionName": "GetQueryCmd",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// pasteProtoImports imports the proto files in the proto code if they are not already imported
+func pasteProtoImports(clip *clipper.Clipper, path, content string, protoImports ...string) (string, error) {
+	var err error
+	for _, protoImport := range protoImports {
+		if strings.Contains(content, fmt.Sprintf(`import "%[1]v";`, protoImport)) {
+			continue
+		}
+
+		importModule := fmt.Sprintf(`
+import "%[1]v";`, protoImport)
+		content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+		if err != nil {
+			return content, err
+		}
+	}
+	return content, nil
+}
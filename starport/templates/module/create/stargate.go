@@ -35,6 +35,11 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 			"stargate/",
 			opts.AppPath,
 		)
+		paramsTemplate = xgenny.NewEmbedWalker(
+			fsParams,
+			"params/",
+			opts.AppPath,
+		)
 	)
 
 	if err := g.Box(msgServerTemplate); err != nil {
@@ -46,6 +51,9 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 	if err := g.Box(stargateTemplate); err != nil {
 		return g, err
 	}
+	if err := g.Box(paramsTemplate); err != nil {
+		return g, err
+	}
 	ctx := plush.NewContext()
 	ctx.Set("moduleName", opts.ModuleName)
 	ctx.Set("modulePath", opts.ModulePath)
@@ -78,6 +86,9 @@ func NewStargateAppModify(clip *clipper.Clipper, opts *CreateOptions) *genny.Gen
 	if opts.IsIBC {
 		g.RunFn(appIBCModify(clip, opts))
 	}
+	if opts.IBCMiddleware != "" {
+		g.RunFn(appIBCMiddlewareModify(clip, opts))
+	}
 	return g
 }
 
@@ -8,9 +8,15 @@ var (
 	//go:embed stargate/* stargate/**/*
 	fsStargate embed.FS
 
+	//go:embed params/* params/**/*
+	fsParams embed.FS
+
 	//go:embed ibc/* ibc/**/*
 	fsIBC embed.FS
 
+	//go:embed ibcmiddleware/* ibcmiddleware/**/*
+	fsIBCMiddleware embed.FS
+
 	//go:embed msgserver/* msgserver/**/*
 	fsMsgServer embed.FS
 
@@ -19,4 +25,19 @@ var (
 
 	//go:embed simapp/* simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed events/* events/**/*
+	fsEvents embed.FS
+
+	//go:embed proposals/* proposals/**/*
+	fsProposals embed.FS
+
+	//go:embed migrator/* migrator/**/*
+	fsMigrator embed.FS
+
+	//go:embed keeperexport/* keeperexport/**/*
+	fsKeeperExport embed.FS
+
+	//go:embed admins/* admins/**/*
+	fsAdmins embed.FS
 )
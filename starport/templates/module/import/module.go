@@ -0,0 +1,322 @@
+package moduleimport
+
+import (
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/wiring"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+// change is a modification of app.go wiring a module imported from a Go module into the app
+type change struct {
+	// description of the change in the checklist of a module which can't be wired automatically
+	description string
+	snippet     string
+	paste       func(clip *clipper.Clipper, path, content, snippet string) (string, error)
+}
+
+// NewStargateModule returns the generator to wire a module imported from a Go module into a Stargate app
+func NewStargateModule(clip *clipper.Clipper, opts *ModuleOptions) (*genny.Generator, error) {
+	if !opts.Module.Resolved() {
+		return nil, fmt.Errorf("the module %s can't be wired automatically into the app", opts.Module.PkgPath)
+	}
+
+	g := genny.New()
+	g.RunFn(appModifyModule(clip, opts))
+	return g, nil
+}
+
+// Checklist returns the steps to wire manually a module imported from a Go module into a Stargate app, the values the
+// app can't give automatically to the constructors of the module are marked with a comment
+func Checklist(opts *ModuleOptions) []string {
+	var steps []string
+	if opts.GoGetPackage != "" {
+		steps = append(steps, fmt.Sprintf("Add the Go module of the module to the requirements of the app:\n\n\tgo get %s\n", opts.GoGetPackage))
+	}
+	for _, c := range changes(opts) {
+		snippet := "\t" + strings.ReplaceAll(c.snippet, "\n", "\n\t")
+		steps = append(steps, fmt.Sprintf("%s:\n\n%s\n", c.description, snippet))
+	}
+	return steps
+}
+
+// app.go modification on Stargate when importing a module from a Go module
+func appModifyModule(clip *clipper.Clipper, opts *ModuleOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, c := range changes(opts) {
+			content, err = c.paste(clip, path, content, c.snippet)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// changes returns the modifications of app.go wiring the module into the app in the order they are applied
+func changes(opts *ModuleOptions) []change {
+	var (
+		m           = opts.Module
+		name        = opts.ModuleName.LowerCase
+		typesAlias  = typesImportName(opts)
+		moduleVar   = name + "Module"
+		keeperField = opts.ModuleName.UpperCamel + "Keeper"
+		changes     []change
+	)
+
+	imports := []string{fmt.Sprintf(`%smodule "%s"`, name, m.PkgPath)}
+	if m.TypesPkgPath != m.PkgPath {
+		imports = append(imports, fmt.Sprintf(`%s "%s"`, typesAlias, m.TypesPkgPath))
+	}
+	if m.Keeper != nil {
+		imports = append(imports, fmt.Sprintf(`%smodulekeeper "%s"`, name, m.KeeperPkgPath))
+	}
+	changes = append(changes, change{
+		description: "Import the packages of the module in app/app.go",
+		snippet:     strings.Join(imports, "\n"),
+		paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+			return clip.PasteGoImportSnippetAt(path, content, strings.ReplaceAll(snippet, "\n", "\n\t"))
+		},
+	})
+
+	changes = append(changes, change{
+		description: "Add the AppModuleBasic of the module to the basic manager returned by newModuleBasics",
+		snippet:     fmt.Sprintf("%smodule.%s{}", name, m.AppModuleBasic),
+		paste: pasteWithPlaceholder(module.PlaceholderSgAppModuleBasic, func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+			return clip.PasteGoReturningFunctionNewArgumentSnippetAt(path, content, snippet, clipper.SelectOptions{
+				"functionName": "newModuleBasics",
+			})
+		}),
+	})
+
+	if m.Keeper != nil {
+		changes = append(changes, change{
+			description: "Declare the keeper of the module in the App struct",
+			snippet:     fmt.Sprintf("%s %smodulekeeper.Keeper", keeperField, name),
+			paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+				if strings.Count(content, module.PlaceholderSgAppKeeperDeclaration) != 0 {
+					// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+					snippet = fmt.Sprintf("\n%s\n%s", snippet, module.PlaceholderSgAppKeeperDeclaration)
+					return clip.Replace(content, module.PlaceholderSgAppKeeperDeclaration, snippet), nil
+				}
+				// And for newer codebase, we use clipper mechanism.
+				return clip.PasteCodeSnippetAt(path, content, clipper.GoSelectStructNewFieldPosition, clipper.SelectOptions{
+					"structName": "App",
+				}, fmt.Sprintf("\n%s\n", snippet))
+			},
+		})
+	}
+
+	if m.Uses(wiring.ArgumentStoreKey) {
+		changes = append(changes, change{
+			description: "Add the store key of the module to the store keys returned by newAppKVStoreKeys",
+			snippet:     importName(opts, m.StoreKeyPkgPath) + ".StoreKey",
+			paste: pasteWithPlaceholder(module.PlaceholderSgAppStoreKey, func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+				return clip.PasteGoReturningFunctionNewArgumentSnippetAt(path, content, snippet, clipper.SelectOptions{
+					"functionName": "newAppKVStoreKeys",
+				})
+			}),
+		})
+	}
+
+	if m.Uses(wiring.ArgumentMemStoreKey) {
+		changes = append(changes, change{
+			description: "Add the memory store key of the module to the arguments of sdk.NewMemoryStoreKeys in New",
+			snippet:     importName(opts, m.MemStoreKeyPkgPath) + ".MemStoreKey",
+			paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+				return clip.PasteGoFunctionCallNewArgumentSnippetAt(path, content, snippet, clipper.SelectOptions{
+					"functionName": "New",
+					"callName":     "sdk.NewMemoryStoreKeys",
+				})
+			},
+		})
+	}
+
+	if m.Uses(wiring.ArgumentSubspace) {
+		changes = append(changes, change{
+			description: "Create the params subspace of the module in initParamsKeeper",
+			snippet:     fmt.Sprintf("paramsKeeper.Subspace(%s.ModuleName)", typesAlias),
+			paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+				if strings.Count(content, module.PlaceholderSgAppParamSubspace) != 0 {
+					// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+					snippet = module.PlaceholderSgAppParamSubspace + "\n" + snippet
+					return clip.Replace(content, module.PlaceholderSgAppParamSubspace, snippet), nil
+				}
+				// And for newer codebase, we use clipper mechanism.
+				return clip.PasteGoBeforeReturnSnippetAt(path, content, snippet, clipper.SelectOptions{
+					"functionName": "initParamsKeeper",
+				})
+			},
+		})
+	}
+
+	// The keeper and the app module are created once all the keepers of the app are
+	definition := fmt.Sprintf("%s := %s", moduleVar, call(opts, name+"module", m.AppModule))
+	if m.Keeper != nil {
+		keeper := call(opts, name+"modulekeeper", *m.Keeper)
+		if m.Keeper.ReturnsPointer {
+			keeper = "*" + keeper
+		}
+		definition = fmt.Sprintf("app.%s = %s\n%s", keeperField, keeper, definition)
+	}
+	changes = append(changes, change{
+		description: "Create the keeper and the app module of the module in New before the module manager",
+		snippet:     definition,
+		paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+			if strings.Count(content, module.PlaceholderSgAppKeeperDefinition) != 0 {
+				// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+				snippet = fmt.Sprintf("%s\n%s", module.PlaceholderSgAppKeeperDefinition, snippet)
+				return clip.Replace(content, module.PlaceholderSgAppKeeperDefinition, snippet), nil
+			}
+			// And for newer codebase, we use clipper mechanism.
+			return clip.PasteCodeSnippetAt(path, content, clipper.GoSelectBeforeCallingStatementPosition, clipper.SelectOptions{
+				"functionName": "New",
+				"callName":     "module.NewManager",
+			}, snippet+"\n\n")
+		},
+	})
+
+	changes = append(changes, change{
+		description: "Add the app module of the module to the arguments of module.NewManager in New",
+		snippet:     moduleVar,
+		paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+			if strings.Count(content, module.PlaceholderSgAppAppModule) != 0 {
+				// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+				// The first placeholder is the one of the module manager, the module isn't added to the simulations.
+				snippet = fmt.Sprintf("%s,\n%s", snippet, module.PlaceholderSgAppAppModule)
+				return clip.Replace(content, module.PlaceholderSgAppAppModule, snippet), nil
+			}
+			// And for newer codebase, we use clipper mechanism.
+			return clip.PasteGoFunctionCallNewArgumentSnippetAt(path, content, snippet, clipper.SelectOptions{
+				"functionName": "New",
+				"callName":     "module.NewManager",
+			})
+		},
+	})
+
+	for _, order := range []struct {
+		call, description string
+	}{
+		{"app.mm.SetOrderBeginBlockers", "begin blockers"},
+		{"app.mm.SetOrderEndBlockers", "end blockers"},
+	} {
+		callName := order.call
+		changes = append(changes, change{
+			description: fmt.Sprintf("Add the module to the order of the %s set with %s in New", order.description, callName),
+			snippet:     typesAlias + ".ModuleName",
+			paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+				if strings.Contains(content, callName+"("+funcWithUnorderedModules+"(") {
+					// The order already includes all the modules of the manager, the module is appended to it.
+					return content, nil
+				}
+				return clip.PasteGoFunctionCallNewArgumentSnippetAt(path, content, snippet, clipper.SelectOptions{
+					"functionName": "New",
+					"callName":     callName,
+				})
+			},
+		})
+	}
+
+	changes = append(changes, change{
+		description: "Add the module to the order of the genesis initialization returned by orderedInitGenesisModuleNames",
+		snippet:     typesAlias + ".ModuleName",
+		paste: func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+			if strings.Count(content, module.PlaceholderSgAppInitGenesis) != 0 {
+				// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+				snippet += ",\n" + module.PlaceholderSgAppInitGenesis
+				return clip.Replace(content, module.PlaceholderSgAppInitGenesis, snippet), nil
+			}
+			// And for newer codebase, we use clipper mechanism.
+			return clip.PasteGoReturningCompositeNewArgumentSnippetAt(path, content, snippet, clipper.SelectOptions{
+				"functionName": "orderedInitGenesisModuleNames",
+			})
+		},
+	})
+
+	return changes
+}
+
+// pasteWithPlaceholder pastes a snippet as an element of a list before a placeholder if the code uses it, with
+// the clipper mechanism otherwise
+func pasteWithPlaceholder(
+	placeholder string,
+	paste func(clip *clipper.Clipper, path, content, snippet string) (string, error),
+) func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+	return func(clip *clipper.Clipper, path, content, snippet string) (string, error) {
+		if strings.Count(content, placeholder) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			snippet += ",\n" + placeholder
+			return clip.Replace(content, placeholder, snippet), nil
+		}
+		// And for newer codebase, we use clipper mechanism.
+		return paste(clip, path, content, snippet)
+	}
+}
+
+// call returns the call of a constructor of the module with the values given by the app, a value which can't be
+// given automatically is replaced by a comment describing the parameter
+func call(opts *ModuleOptions, pkgAlias string, c wiring.Constructor) string {
+	var (
+		typesAlias  = typesImportName(opts)
+		keeperField = opts.ModuleName.UpperCamel + "Keeper"
+		qualifier   = func(pkg *types.Package) string { return pkg.Name() }
+		args        []string
+	)
+	for _, arg := range c.Arguments {
+		switch arg.Kind {
+		case wiring.ArgumentApp:
+			args = append(args, arg.Expression)
+		case wiring.ArgumentKeeper:
+			args = append(args, "app."+keeperField)
+		case wiring.ArgumentKeeperReference:
+			args = append(args, "&app."+keeperField)
+		case wiring.ArgumentStoreKey:
+			args = append(args, fmt.Sprintf("keys[%s.StoreKey]", importName(opts, opts.Module.StoreKeyPkgPath)))
+		case wiring.ArgumentMemStoreKey:
+			args = append(args, fmt.Sprintf("memKeys[%s.MemStoreKey]", importName(opts, opts.Module.MemStoreKeyPkgPath)))
+		case wiring.ArgumentSubspace:
+			args = append(args, fmt.Sprintf("app.GetSubspace(%s.ModuleName)", typesAlias))
+		default:
+			hint := "not found in the app"
+			if len(arg.Candidates) > 0 {
+				hint = "one of " + strings.Join(arg.Candidates, ", ")
+			}
+			args = append(args, fmt.Sprintf("/* %s %s: %s */", arg.Name, types.TypeString(arg.Type, qualifier), hint))
+		}
+	}
+
+	if len(args) == 0 {
+		return fmt.Sprintf("%s.%s()", pkgAlias, c.Name)
+	}
+	return fmt.Sprintf("%s.%s(\n\t%s,\n)", pkgAlias, c.Name, strings.Join(args, ",\n\t"))
+}
+
+// typesImportName returns the name of the import of the types package of the module in app.go
+func typesImportName(opts *ModuleOptions) string {
+	return importName(opts, opts.Module.TypesPkgPath)
+}
+
+// importName returns the name of the import of a package of the module in app.go
+func importName(opts *ModuleOptions, pkgPath string) string {
+	switch pkgPath {
+	case opts.Module.PkgPath:
+		return opts.ModuleName.LowerCase + "module"
+	case opts.Module.KeeperPkgPath:
+		return opts.ModuleName.LowerCase + "modulekeeper"
+	}
+	return opts.ModuleName.LowerCase + "moduletypes"
+}
@@ -0,0 +1,189 @@
+package moduleimport
+
+import (
+	"go/format"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/wiring"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+const appGo = `package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+)
+
+func newModuleBasics() module.BasicManager {
+	return module.NewBasicManager(
+		bank.AppModuleBasic{},
+	)
+}
+
+type App struct {
+	BankKeeper bankkeeper.Keeper
+}
+
+func New() *App {
+	app := &App{}
+	keys := newAppKVStoreKeys()
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+
+	app.mm = module.NewManager(
+		bank.NewAppModule(app.BankKeeper),
+	)
+
+	app.mm.SetOrderBeginBlockers(banktypes.ModuleName)
+	app.mm.SetOrderEndBlockers(banktypes.ModuleName)
+	return app
+}
+
+func initParamsKeeper(key sdk.StoreKey) paramskeeper.Keeper {
+	paramsKeeper := paramskeeper.NewKeeper(key)
+	paramsKeeper.Subspace(banktypes.ModuleName)
+	return paramsKeeper
+}
+
+func newAppKVStoreKeys() map[string]*sdk.KVStoreKey {
+	return sdk.NewKVStoreKeys(
+		banktypes.StoreKey,
+	)
+}
+
+func orderedInitGenesisModuleNames() []string {
+	return []string{
+		banktypes.ModuleName,
+	}
+}
+`
+
+func newOptions(t *testing.T, resolved bool) *ModuleOptions {
+	t.Helper()
+
+	name, err := multiformatname.NewName("mint")
+	require.NoError(t, err)
+
+	bankKeeper := wiring.Argument{
+		Name:       "bankKeeper",
+		Kind:       wiring.ArgumentApp,
+		Expression: "app.BankKeeper",
+	}
+	if !resolved {
+		bankKeeper = wiring.Argument{
+			Name: "bankKeeper",
+			Type: types.NewNamed(
+				types.NewTypeName(token.NoPos, types.NewPackage("github.com/foo/bank/types", "types"), "BankKeeper", nil),
+				types.NewInterfaceType(nil, nil),
+				nil,
+			),
+			Candidates: []string{"app.BankKeeper"},
+		}
+	}
+
+	return &ModuleOptions{
+		ModuleName: name,
+		Module: wiring.Module{
+			PkgPath:            "github.com/foo/mint",
+			KeeperPkgPath:      "github.com/foo/mint/keeper",
+			TypesPkgPath:       "github.com/foo/mint/types",
+			StoreKeyPkgPath:    "github.com/foo/mint/types",
+			MemStoreKeyPkgPath: "github.com/foo/mint/types",
+			Name:               "mint",
+			AppModuleBasic:     "AppModuleBasic",
+			Keeper: &wiring.Constructor{
+				Name:           "NewKeeper",
+				ReturnsPointer: true,
+				Arguments: []wiring.Argument{
+					{Name: "key", Kind: wiring.ArgumentStoreKey},
+					{Name: "memKey", Kind: wiring.ArgumentMemStoreKey},
+					{Name: "ps", Kind: wiring.ArgumentSubspace},
+					bankKeeper,
+				},
+			},
+			AppModule: wiring.Constructor{
+				Name:      "NewAppModule",
+				Arguments: []wiring.Argument{{Name: "keeper", Kind: wiring.ArgumentKeeper}},
+			},
+		},
+	}
+}
+
+func TestChanges(t *testing.T) {
+	var (
+		opts    = newOptions(t, true)
+		clip    = clipper.New()
+		content = appGo
+		err     error
+	)
+	require.True(t, opts.Module.Resolved())
+
+	for _, c := range changes(opts) {
+		content, err = c.paste(clip, "app.go", content, c.snippet)
+		require.NoError(t, err, c.description)
+	}
+	require.NoError(t, clip.Err())
+
+	// The app is formatted once wired
+	formatted, err := format.Source([]byte(content))
+	require.NoError(t, err)
+	content = string(formatted)
+
+	for _, snippet := range []string{
+		`mintmodule "github.com/foo/mint"`,
+		`mintmoduletypes "github.com/foo/mint/types"`,
+		`mintmodulekeeper "github.com/foo/mint/keeper"`,
+		"mintmodule.AppModuleBasic{}",
+		"MintKeeper mintmodulekeeper.Keeper",
+		"mintmoduletypes.StoreKey,",
+		"sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, mintmoduletypes.MemStoreKey)",
+		"paramsKeeper.Subspace(mintmoduletypes.ModuleName)",
+		`app.MintKeeper = *mintmodulekeeper.NewKeeper(
+		keys[mintmoduletypes.StoreKey],
+		memKeys[mintmoduletypes.MemStoreKey],
+		app.GetSubspace(mintmoduletypes.ModuleName),
+		app.BankKeeper,
+	)`,
+		"mintModule := mintmodule.NewAppModule(\n\t\tapp.MintKeeper,\n\t)",
+		"mintModule,",
+		"app.mm.SetOrderBeginBlockers(banktypes.ModuleName, mintmoduletypes.ModuleName)",
+		"app.mm.SetOrderEndBlockers(banktypes.ModuleName, mintmoduletypes.ModuleName)",
+		"mintmoduletypes.ModuleName,\n\t}",
+	} {
+		require.Contains(t, content, snippet)
+	}
+
+	// The keeper and the app module are created before the module manager
+	require.Less(t, strings.Index(content, "mintModule :="), strings.Index(content, "module.NewManager("))
+}
+
+func TestChecklist(t *testing.T) {
+	opts := newOptions(t, false)
+	require.False(t, opts.Module.Resolved())
+
+	steps := Checklist(opts)
+	require.Len(t, steps, len(changes(opts)))
+	require.Contains(t, steps[0], "Import the packages of the module in app/app.go")
+	require.True(t, strings.HasSuffix(steps[len(steps)-1], "\n"))
+
+	var keeper string
+	for _, step := range steps {
+		if strings.HasPrefix(step, "Create the keeper and the app module of the module") {
+			keeper = step
+		}
+	}
+	require.Contains(t, keeper, "/* bankKeeper types.BankKeeper: one of app.BankKeeper */")
+
+	opts.GoGetPackage = "github.com/foo/mint@v1.0.0"
+	stepsWithGoGet := Checklist(opts)
+	require.Len(t, stepsWithGoGet, len(steps)+1)
+	require.Equal(t, "Add the Go module of the module to the requirements of the app:\n\n\tgo get github.com/foo/mint@v1.0.0\n", stepsWithGoGet[0])
+	require.Equal(t, steps, stepsWithGoGet[1:])
+}
@@ -1,5 +1,10 @@
 package moduleimport
 
+import (
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/wiring"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
 // ImportOptions ...
 type ImportOptions struct {
 	AppName          string
@@ -12,3 +17,14 @@ type ImportOptions struct {
 func (opts *ImportOptions) Validate() error {
 	return nil
 }
+
+// ModuleOptions are the options to wire a module imported from a Go module into the app
+type ModuleOptions struct {
+	AppPath    string
+	ModuleName multiformatname.Name
+	Module     wiring.Module
+
+	// GoGetPackage is the package given to go get to require the Go module of the module, it is empty when the Go
+	// module is already required by the app
+	GoGetPackage string
+}
@@ -1,26 +1,50 @@
 package moduleimport
 
 import (
+	"embed"
 	"fmt"
 	"path/filepath"
 	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
 	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
 	"github.com/tendermint/starport/starport/templates/module"
 )
 
+const (
+	pathAnteHandler = "app/ante/handler.go"
+
+	// funcWithUnorderedModules is the function of the app completing the orders of the module manager
+	funcWithUnorderedModules = "withUnorderedModules"
+)
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
 // NewStargate returns the generator to scaffold code to import wasm module inside a Stargate app
+// the app must use the ante handler of the app to chain the wasm decorators
 func NewStargate(clip *clipper.Clipper, opts *ImportOptions) (*genny.Generator, error) {
-	g := genny.New()
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+	)
+
 	g.RunFn(appModifyStargate(clip, opts))
+	g.RunFn(anteHandlerModifyStargate(clip, opts))
 	g.RunFn(cmdModifyStargate(clip, opts))
 
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
 	ctx := plush.NewContext()
 	ctx.Set("AppName", opts.AppName)
 	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
 
 	return g, nil
 }
@@ -34,40 +58,28 @@ func appModifyStargate(clip *clipper.Clipper, opts *ImportOptions) genny.RunFn {
 			return err
 		}
 
-		importSnippet := `"github.com/tendermint/spm-extras/wasmcmd"
-	"github.com/CosmWasm/wasmd/x/wasm"
+		importSnippet := `"github.com/CosmWasm/wasmd/x/wasm"
 	wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"`
 		content, err := clip.PasteGoImportSnippetAt(path, f.String(), importSnippet)
 		if err != nil {
 			return err
 		}
 
-		enabledProposalsSnippet := `
-var (
-	// If EnabledSpecificProposals is "", and this is "true", then enable all x/wasm proposals.
-	// If EnabledSpecificProposals is "", and this is not "true", then disable all x/wasm proposals.
-	ProposalsEnabled = "false"
-	// If set to non-empty string it must be comma-separated list of values that are all a subset
-	// of "EnableAllProposals" (takes precedence over ProposalsEnabled)
-	// https://github.com/CosmWasm/wasmd/blob/02a54d33ff2c064f3539ae12d75d027d9c665f05/x/wasm/internal/types/proposal.go#L28-L34
-	EnableSpecificProposals = ""
-)`
+		// Register the client handlers providing the CLI commands to submit the wasm proposals
 		content, err = clip.PasteCodeSnippetAt(
 			path,
 			content,
-			clipper.GoSelectNewGlobalPosition,
-			nil,
-			enabledProposalsSnippet,
+			clipper.GoSelectBeforeCallingStatementPosition,
+			clipper.SelectOptions{
+				"functionName": "getGovProposalHandlers",
+				"callName":     "additionalGovProposalHandlers",
+			},
+			"govProposalHandlers = append(govProposalHandlers, wasmclient.ProposalHandlers...)\n\n\t",
 		)
 		if err != nil {
 			return err
 		}
 
-		templateGovProposalHandlers := `%[1]v
-		govProposalHandlers = wasmclient.ProposalHandlers`
-		replacementProposalHandlers := fmt.Sprintf(templateGovProposalHandlers, module.PlaceholderSgAppGovProposalHandlers)
-		content = clip.Replace(content, module.PlaceholderSgAppGovProposalHandlers, replacementProposalHandlers)
-
 		templateModuleBasic := `wasm.AppModuleBasic{}`
 		if strings.Count(content, module.PlaceholderSgAppModuleBasic) != 0 {
 			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
@@ -113,28 +125,7 @@ var (
 			}
 		}
 
-		templateDeclaration := `%[1]v
-		scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
-		`
-		snippet := fmt.Sprintf(templateDeclaration, module.PlaceholderSgAppScopedKeeper)
-		content = clip.Replace(content, module.PlaceholderSgAppScopedKeeper, snippet)
-
-		beforeInitReturnSnippet := `app.scopedWasmKeeper = scopedWasmKeeper`
-		if strings.Count(content, module.PlaceholderSgAppBeforeInitReturn) != 0 {
-			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
-			beforeInitReturnSnippet = module.PlaceholderSgAppBeforeInitReturn + "\n" + beforeInitReturnSnippet
-			content = clip.Replace(content, module.PlaceholderSgAppBeforeInitReturn, beforeInitReturnSnippet)
-		} else {
-			// And for newer codebase, we use clipper mechanism.
-			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, beforeInitReturnSnippet, clipper.SelectOptions{
-				"functionName": "New",
-			})
-			if err != nil {
-				return err
-			}
-		}
-
-		snippet = `wasm.StoreKey`
+		snippet := `wasm.StoreKey`
 		if strings.Count(content, module.PlaceholderSgAppStoreKey) != 0 {
 			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
 			snippet += ",\n" + module.PlaceholderSgAppStoreKey
@@ -154,48 +145,93 @@ var (
 			}
 		}
 
-		templateKeeperDefinition := `%[1]v
-		wasmDir := filepath.Join(homePath, "wasm")
-	
-		wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+		// The wasm keeper is created and its proposals are routed before the gov keeper seals the gov router
+		templateKeeperDefinition := `scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
+	app.scopedWasmKeeper = scopedWasmKeeper
+
+	wasmDir := filepath.Join(homePath, "wasm")
+	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+	if err != nil {
+		panic("error while reading wasm config: " + err.Error())
+	}
+
+	// The last arguments can contain custom message handlers, and custom query handlers,
+	// if we want to allow any custom callbacks
+	app.wasmKeeper = wasm.NewKeeper(
+		appCodec,
+		keys[wasm.StoreKey],
+		app.GetSubspace(wasm.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.DistrKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedWasmKeeper,
+		app.TransferKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		wasmDir,
+		wasmConfig,
+		wasmSupportedFeatures,
+	)
+
+	// The gov proposal types can be individually enabled
+	if enabledProposals := GetEnabledProposals(); len(enabledProposals) != 0 {
+		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper, enabledProposals))
+	}
+
+	`
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectBeforeCallingStatementPosition,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"callName":     "govkeeper.NewKeeper",
+			},
+			templateKeeperDefinition,
+		)
 		if err != nil {
-			panic("error while reading wasm config: " + err.Error())
+			return err
 		}
 
-		// The last arguments can contain custom message handlers, and custom query handlers,
-		// if we want to allow any custom callbacks
-		supportedFeatures := "staking"
-		app.wasmKeeper = wasm.NewKeeper(
-				appCodec,
-				keys[wasm.StoreKey],
-				app.GetSubspace(wasm.ModuleName),
-				app.AccountKeeper,
-				app.BankKeeper,
-				app.StakingKeeper,
-				app.DistrKeeper,
-				app.IBCKeeper.ChannelKeeper,
-				&app.IBCKeeper.PortKeeper,
-				scopedWasmKeeper,
-				app.TransferKeeper,
-				app.Router(),
-				app.GRPCQueryRouter(),
-				wasmDir,
-				wasmConfig,
-				supportedFeatures,
+		// Route the IBC packets of the contracts, the IBC router is sealed when it is set
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectBeforeCallingStatementPosition,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"callName":     "app.IBCKeeper.SetRouter",
+			},
+			"ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.wasmKeeper, app.IBCKeeper.ChannelKeeper))\n\t",
 		)
-	
-		// The gov proposal types can be individually enabled
-		enabledProposals := wasmcmd.GetEnabledProposals(ProposalsEnabled, EnableSpecificProposals)
-		if len(enabledProposals) != 0 {
-			govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper, enabledProposals))
-		}`
-		replacementKeeperDefinition := fmt.Sprintf(templateKeeperDefinition, module.PlaceholderSgAppKeeperDefinition)
-		content = clip.Replace(content, module.PlaceholderSgAppKeeperDefinition, replacementKeeperDefinition)
-
-		templateAppModule := `%[1]v
-		wasm.NewAppModule(appCodec, &app.wasmKeeper, app.StakingKeeper),`
-		replacementAppModule := fmt.Sprintf(templateAppModule, module.PlaceholderSgAppAppModule)
-		content = clip.Replace(content, module.PlaceholderSgAppAppModule, replacementAppModule)
+		if err != nil {
+			return err
+		}
+
+		templateAppModule := `wasm.NewAppModule(appCodec, &app.wasmKeeper, app.StakingKeeper)`
+		if strings.Count(content, module.PlaceholderSgAppAppModule) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			// The first placeholder is the one of the module manager, the module isn't added to the simulations.
+			templateAppModule += ",\n" + module.PlaceholderSgAppAppModule
+			content = clip.Replace(content, module.PlaceholderSgAppAppModule, templateAppModule)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoFunctionCallNewArgumentSnippetAt(
+				path,
+				content,
+				templateAppModule,
+				clipper.SelectOptions{
+					"functionName": "New",
+					"callName":     "module.NewManager",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
 
 		snippet = `wasm.ModuleName`
 		if strings.Count(content, module.PlaceholderSgAppInitGenesis) != 0 {
@@ -232,6 +268,155 @@ var (
 			}
 		}
 
+		// The Cosmos SDK version required by wasmd requires the orders to include all the modules of the manager
+		content, err = clip.ReplaceCodeSnippetsAt(
+			path,
+			content,
+			clipper.GoSelectStatementsReferencing,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"names":        "SetOrderBeginBlockers,SetOrderEndBlockers,SetOrderInitGenesis",
+			},
+			func(data interface{}) string {
+				statement := data.(string)
+				start, end := strings.Index(statement, "("), strings.LastIndex(statement, ")")
+				if start < 0 || end < start || strings.Contains(statement, funcWithUnorderedModules) {
+					return statement
+				}
+				args := statement[start+1 : end]
+				if !strings.HasPrefix(args, "\n") {
+					args = " " + args
+				}
+				return statement[:start+1] + funcWithUnorderedModules + "(app.mm," + args + ")..." + statement[end:]
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		// Give the dependencies of the wasm decorators to the ante handler of the app
+		var extended bool
+		content, err = clip.ReplaceCodeSnippetsAt(
+			path,
+			content,
+			clipper.GoSelectStatementsReferencing,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"names":        "NewAnteHandler",
+			},
+			func(data interface{}) string {
+				statement := data.(string)
+				end := strings.LastIndex(statement, "}")
+				if !strings.Contains(statement, "HandlerOptions:") || end < 0 {
+					return statement
+				}
+				extended = true
+				return statement[:end] + `	WasmConfig:        &wasmConfig,
+			TXCounterStoreKey: keys[wasm.StoreKey],
+		` + statement[end:]
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if !extended {
+			return fmt.Errorf("the options of the ante handler of %s can't be extended, the ante handler of the app isn't used", path)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// handler.go modification on Stargate when importing wasm to chain the wasm decorators
+func anteHandlerModifyStargate(clip *clipper.Clipper, opts *ImportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, pathAnteHandler)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		importSnippet := `wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"`
+		content, err := clip.PasteGoImportSnippetAt(path, f.String(), importSnippet)
+		if err != nil {
+			return err
+		}
+
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectStructNewFieldPosition,
+			clipper.SelectOptions{
+				"structName": "HandlerOptions",
+			},
+			`
+	WasmConfig        *wasmtypes.WasmConfig
+	TXCounterStoreKey sdk.StoreKey
+`,
+		)
+		if err != nil {
+			return err
+		}
+
+		templateChecks := `if options.WasmConfig == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "wasm config is required for ante builder")
+	}
+
+	if options.TXCounterStoreKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "tx counter key is required for ante builder")
+	}
+
+	`
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectBeforeCallingStatementPosition,
+			clipper.SelectOptions{
+				"functionName": "NewAnteHandler",
+				"callName":     "ante.NewSetUpContextDecorator",
+			},
+			templateChecks,
+		)
+		if err != nil {
+			return err
+		}
+
+		// The wasm decorators must run right after the context is set up to enforce the gas limits early
+		var chained bool
+		content, err = clip.ReplaceCodeSnippetsAt(
+			path,
+			content,
+			clipper.GoSelectStatementsReferencing,
+			clipper.SelectOptions{
+				"functionName": "NewAnteHandler",
+				"names":        "NewSetUpContextDecorator",
+			},
+			func(data interface{}) string {
+				statement := data.(string)
+				setUp := strings.Index(statement, "ante.NewSetUpContextDecorator()")
+				if setUp < 0 {
+					return statement
+				}
+				end := strings.Index(statement[setUp:], "\n")
+				if end < 0 {
+					return statement
+				}
+				end += setUp + 1
+				chained = true
+				return statement[:end] + `		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit),
+		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreKey),
+` + statement[end:]
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if !chained {
+			return fmt.Errorf("the wasm decorators can't be chained in %s, the SetUpContext decorator isn't found", path)
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -246,11 +431,12 @@ func cmdModifyStargate(clip *clipper.Clipper, opts *ImportOptions) genny.RunFn {
 			return err
 		}
 
-		content := f.String()
-
-		templateArgs := `cosmoscmd.AddSubCmd(wasmcmd.GenesisWasmMsgCmd(app.DefaultNodeHome)),
-cosmoscmd.CustomizeStartCmd(wasmcmd.AddModuleInitFlags)`
+		content, err := clip.PasteGoImportSnippetAt(path, f.String(), `"github.com/CosmWasm/wasmd/x/wasm"`)
+		if err != nil {
+			return err
+		}
 
+		templateArgs := `cosmoscmd.CustomizeStartCmd(wasm.AddModuleInitFlags)`
 		if strings.Count(content, module.PlaceholderSgRootArgument) != 0 {
 			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
 			templateArgs += ",\n" + module.PlaceholderSgRootArgument
@@ -270,10 +456,6 @@ cosmoscmd.CustomizeStartCmd(wasmcmd.AddModuleInitFlags)`
 			}
 		}
 
-		// import spm-extras.
-		content = clip.Replace(content, "package main", `package main
-import "github.com/tendermint/spm-extras/wasmcmd"`)
-
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
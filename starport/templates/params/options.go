@@ -0,0 +1,30 @@
+package params
+
+import (
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+// Options ...
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	Params     field.Fields
+
+	// Overrides customize the generated code of the params by their name
+	Overrides map[string]Override
+}
+
+// Override customizes the generated code of a param
+type Override struct {
+	// Default is the Go code of the default value of the param
+	Default string
+
+	// Validation is the Go code validating the value of the param held by a variable named after the param
+	Validation string
+
+	// GoImports are the imports required by the validation
+	GoImports []datatype.GoImport
+}
@@ -0,0 +1,428 @@
+package params
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+// NewStargate returns the generator to scaffold new params in a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(typesParamsModify(clip, opts))
+	g.RunFn(typesParamsTestModify(opts))
+	g.RunFn(protoParamsModify(clip, opts))
+	g.RunFn(keeperParamsModify(clip, opts))
+	g.RunFn(keeperParamsTestModify(clip, opts))
+	g.RunFn(moduleSimulationModify(clip, opts))
+	g.RunFn(genesisTestModify(clip, opts, "types/genesis_test.go"))
+	g.RunFn(genesisTestModify(clip, opts, "genesis_test.go"))
+
+	return g, nil
+}
+
+// defaultValue returns the Go code of the default value of a param
+func defaultValue(param field.Field, override Override) string {
+	switch {
+	case override.Default != "":
+		return override.Default
+	case len(param.Constraints) > 0:
+		return param.SampleTestValue()
+	case param.DataType() == "string":
+		return fmt.Sprintf("%q", param.Name.Snake)
+	default:
+		return param.ValueIndex()
+	}
+}
+
+// validation returns the Go code validating the value of a param held by the variable
+func validation(param field.Field, override Override, variable string) string {
+	if override.Validation != "" {
+		return override.Validation
+	}
+	if len(param.Constraints) > 0 {
+		return param.ValidateConstraints("params", variable)
+	}
+	return fmt.Sprintf(`// TODO implement validation
+	_ = %[1]v`, variable)
+}
+
+func typesParamsModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		goImports := append([]datatype.GoImport{{Name: "fmt"}}, opts.Params.GoTypeImports()...)
+		if len(opts.Params.Constrained()) > 0 {
+			goImports = append(goImports, datatype.GoImport{
+				Alias: "sdkerrors",
+				Name:  "github.com/cosmos/cosmos-sdk/types/errors",
+			})
+		}
+		for _, override := range opts.Overrides {
+			goImports = append(goImports, override.GoImports...)
+		}
+		content, err := pasteGoImports(clip, path, f.String(), goImports...)
+		if err != nil {
+			return err
+		}
+
+		var (
+			declarations, functions                           string
+			parameters, fields, arguments, pairs, validations []string
+		)
+		for _, param := range opts.Params {
+			override := opts.Overrides[param.Name.LowerCamel]
+			declarations += fmt.Sprintf(`
+var (
+	Key%[1]v = []byte("%[1]v")
+	// TODO: Determine the default value
+	Default%[1]v %[2]v = %[3]v
+)
+`,
+				param.Name.UpperCamel,
+				param.DataType(),
+				defaultValue(param, override),
+			)
+			if vars := param.ValidationVars("params"); vars != "" && override.Validation == "" {
+				declarations += "\n" + vars + "\n"
+			}
+			parameters = append(parameters, param.Name.LowerCamel+" "+param.DataType())
+			fields = append(fields, fmt.Sprintf("%[1]v: %[2]v", param.Name.UpperCamel, param.Name.LowerCamel))
+			arguments = append(arguments, "Default"+param.Name.UpperCamel)
+			pairs = append(pairs, fmt.Sprintf(
+				"paramtypes.NewParamSetPair(Key%[1]v, &p.%[1]v, validate%[1]v)",
+				param.Name.UpperCamel,
+			))
+			validations = append(validations, fmt.Sprintf(`if err := validate%[1]v(p.%[1]v); err != nil {
+		return err
+	}
+`,
+				param.Name.UpperCamel,
+			))
+			functions += fmt.Sprintf(`
+// validate%[1]v validates the %[1]v param
+func validate%[1]v(v interface{}) error {
+	%[2]v, ok := v.(%[3]v)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %%T", v)
+	}
+
+	%[4]v
+
+	return nil
+}
+`,
+				param.Name.UpperCamel,
+				param.Name.LowerCamel,
+				param.DataType(),
+				validation(param, override, param.Name.LowerCamel),
+			)
+		}
+
+		// Key and default value of the params
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectNewGlobalPosition,
+			nil,
+			declarations,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, snippets := range []struct {
+			functionName string
+			values       []string
+			paste        func(path, code, snippet string, options clipper.SelectOptions) (string, error)
+		}{
+			{"NewParams", parameters, clip.PasteGoFunctionNewParameterSnippetAt},
+			{"NewParams", fields, clip.PasteGoReturningCompositeNewArgumentSnippetAt},
+			{"DefaultParams", arguments, clip.PasteGoReturningFunctionNewArgumentSnippetAt},
+			{"ParamSetPairs", pairs, clip.PasteGoReturningCompositeNewArgumentSnippetAt},
+		} {
+			for _, value := range snippets.values {
+				content, err = snippets.paste(path, content, value, clipper.SelectOptions{
+					"functionName": snippets.functionName,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Validation of the params
+		content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, strings.Join(validations, "\n\t"), clipper.SelectOptions{
+			"functionName": "Validate",
+			"receiverType": "Params",
+		})
+		if err != nil {
+			return err
+		}
+		content += functions
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesParamsTestModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, param := range opts.Params {
+			checks := []string{
+				fmt.Sprintf("require.NoError(t, validate%[1]v(Default%[1]v))", param.Name.UpperCamel),
+				fmt.Sprintf("require.Error(t, validate%[1]v(nil))", param.Name.UpperCamel),
+			}
+			for _, invalid := range param.InvalidTestValues() {
+				value := invalid.Code
+				if param.DataType() != "string" {
+					value = fmt.Sprintf("%[1]v(%[2]v)", param.DataType(), invalid.Code)
+				}
+				checks = append(checks, fmt.Sprintf("require.Error(t, validate%[1]v(%[2]v))", param.Name.UpperCamel, value))
+			}
+			content += fmt.Sprintf(`
+func TestValidate%[1]v(t *testing.T) {
+	%[2]v
+}
+`,
+				param.Name.UpperCamel,
+				strings.Join(checks, "\n\t"),
+			)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func protoParamsModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "params.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, protoImport := range append([]string{"gogoproto/gogo.proto"}, opts.Params.ProtoImports()...) {
+			if strings.Contains(content, fmt.Sprintf(`import "%[1]v";`, protoImport)) {
+				continue
+			}
+
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, protoImport)
+			content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, param := range opts.Params {
+			content, err = clip.PasteGeneratedCodeSnippetAt(
+				path,
+				content,
+				clipper.ProtoSelectNewMessageFieldPosition,
+				clipper.SelectOptions{
+					"name": "Params",
+				},
+				func(data interface{}) string {
+					highestNumber := data.(clipper.ProtoNewMessageFieldPositionData).HighestFieldNumber
+					return fmt.Sprintf(
+						"  %[1]v [(gogoproto.moretags) = \"yaml:\\\"%[2]v\\\"\"];\n",
+						param.ProtoType(int(highestNumber)+1),
+						param.Name.Snake,
+					)
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func keeperParamsModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, param := range opts.Params {
+			content, err = clip.PasteGoReturningFunctionNewArgumentSnippetAt(
+				path,
+				content,
+				fmt.Sprintf("k.%[1]v(ctx)", param.Name.UpperCamel),
+				clipper.SelectOptions{
+					"functionName": "GetParams",
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			content += fmt.Sprintf(`
+// %[1]v returns the %[1]v param
+func (k Keeper) %[1]v(ctx sdk.Context) (res %[2]v) {
+	k.paramstore.Get(ctx, types.Key%[1]v, &res)
+	return
+}
+`,
+				param.Name.UpperCamel,
+				param.DataType(),
+			)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func keeperParamsTestModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/params_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var checks []string
+		for _, param := range opts.Params {
+			checks = append(checks, fmt.Sprintf(
+				"require.EqualValues(t, params.%[1]v, k.%[1]v(ctx))",
+				param.Name.UpperCamel,
+			))
+		}
+		content, err := clip.PasteGoBeforeReturnSnippetAt(
+			path,
+			f.String(),
+			strings.Join(checks, "\n\t"),
+			clipper.SelectOptions{
+				"functionName": "TestGetParams",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func moduleSimulationModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module_simulation.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, param := range opts.Params {
+			// The quoted default value is only valid JSON for scalar params
+			if strings.HasPrefix(param.DataType(), "[]") {
+				continue
+			}
+			content, err = pasteGoImports(clip, path, content, datatype.GoImport{Name: "fmt"})
+			if err != nil {
+				return err
+			}
+			snippet := fmt.Sprintf(`simulation.NewSimParamChange(types.ModuleName, string(types.Key%[1]v), func(r *rand.Rand) string {
+			return fmt.Sprintf("\"%%v\"", types.Default%[1]v)
+		})`,
+				param.Name.UpperCamel,
+			)
+			content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+				path,
+				content,
+				snippet,
+				clipper.SelectOptions{
+					"functionName": "RandomizedParams",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// genesisTestModify sets the default params in the genesis state of the tests, the zero params can be invalid
+// the modification is skipped if the test doesn't exist or if the default params are already set
+func genesisTestModify(clip *clipper.Clipper, opts *Options, testFile string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, testFile)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil
+		}
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if strings.Contains(content, "types.DefaultParams()") {
+			return nil
+		}
+		content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+			path,
+			content,
+			"Params: types.DefaultParams()",
+			clipper.SelectOptions{
+				"functionName": "newTestGenesisState",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// pasteGoImports imports the packages in the Go code if they are not already imported
+func pasteGoImports(clip *clipper.Clipper, path, content string, goImports ...datatype.GoImport) (string, error) {
+	var err error
+	for _, goImport := range goImports {
+		if strings.Contains(content, fmt.Sprintf("%q", goImport.Name)) {
+			continue
+		}
+		content, err = clip.PasteGoImportSnippetAt(
+			path,
+			content,
+			strings.TrimSpace(fmt.Sprintf("%s %q", goImport.Alias, goImport.Name)),
+		)
+		if err != nil {
+			return content, err
+		}
+	}
+	return content, nil
+}
@@ -0,0 +1,17 @@
+package proposal
+
+import (
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/templates/field"
+)
+
+// Options ...
+type Options struct {
+	AppName      string
+	AppPath      string
+	ModuleName   string
+	ModulePath   string
+	OwnerName    string
+	ProposalName multiformatname.Name
+	Fields       field.Fields
+}
@@ -0,0 +1,258 @@
+package proposal
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+// NewStargate returns the generator to scaffold a governance proposal in a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoProposalModify(clip, opts))
+	g.RunFn(typesCodecModify(clip, opts))
+	g.RunFn(proposalHandlerModify(clip, opts))
+	g.RunFn(appModify(clip, opts))
+
+	template := xgenny.NewEmbedWalker(
+		fsStargate,
+		"stargate/",
+		opts.AppPath,
+	)
+	return g, Box(template, opts, g)
+}
+
+func protoProposalModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "proposal.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// the title and the description are the fields of every proposal content
+		var proposalFields string
+		for i, field := range opts.Fields {
+			proposalFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+3))
+		}
+
+		template := `
+
+message %[1]vProposal {
+  string title = 1;
+  string description = 2;
+%[2]v}`
+		replacement := fmt.Sprintf(template,
+			opts.ProposalName.UpperCamel,
+			proposalFields,
+		)
+		content, err := clip.PasteCodeSnippetAt(
+			path,
+			f.String(),
+			clipper.ProtoSelectLastPosition,
+			nil,
+			replacement,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Ensure custom types are imported
+		protoImports := opts.Fields.ProtoImports()
+		for _, f := range opts.Fields.Custom() {
+			protoImports = append(protoImports,
+				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
+			)
+		}
+		for _, f := range protoImports {
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, f)
+			content = strings.ReplaceAll(content, importModule, "")
+			content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesCodecModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/codec.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		importSnippet := `govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"`
+		content, err := clip.PasteGoImportSnippetAt(path, f.String(), importSnippet)
+		if err != nil {
+			return err
+		}
+
+		templateRegisterConcrete := `
+	cdc.RegisterConcrete(&%[1]vProposal{}, "%[2]v/%[1]vProposal", nil)`
+		startOfFunctionSnippet := fmt.Sprintf(
+			templateRegisterConcrete,
+			opts.ProposalName.UpperCamel,
+			opts.ModuleName,
+		)
+
+		if strings.Count(content, module.Placeholder2) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			startOfFunctionSnippet += "\n" + module.Placeholder2
+			content = clip.Replace(content, module.Placeholder2, startOfFunctionSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectStartOfFunctionPosition,
+				clipper.SelectOptions{
+					"functionName": "RegisterCodec",
+				},
+				startOfFunctionSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		templateRegisterImplementations := `
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&%[1]vProposal{},
+	)`
+		startOfFunctionSnippet = fmt.Sprintf(
+			templateRegisterImplementations,
+			opts.ProposalName.UpperCamel,
+		)
+
+		if strings.Count(content, module.Placeholder3) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			startOfFunctionSnippet += "\n" + module.Placeholder3
+			content = clip.Replace(content, module.Placeholder3, startOfFunctionSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectStartOfFunctionPosition,
+				clipper.SelectOptions{
+					"functionName": "RegisterInterfaces",
+				},
+				startOfFunctionSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func proposalHandlerModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "proposal_handler.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `case *types.%[1]vProposal:
+			return k.Handle%[1]vProposal(ctx, c)
+		`
+		content, err := clip.PasteCodeSnippetAt(
+			path,
+			f.String(),
+			clipper.GoSelectNewCaseClausePosition,
+			clipper.SelectOptions{
+				"functionName": "NewProposalHandler",
+			},
+			fmt.Sprintf(template, opts.ProposalName.UpperCamel),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// app.go modification to register the proposal client handler and the proposal route of the module
+func appModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `%[1]vmodule "%[2]v/x/%[1]v"
+	%[1]vmoduleclient "%[2]v/x/%[1]v/client"
+	%[1]vmoduletypes "%[2]v/x/%[1]v/types"`
+		importSnippet := fmt.Sprintf(template, opts.ModuleName, opts.ModulePath)
+		content, err := clip.PasteGoImportSnippetAt(path, f.String(), importSnippet)
+		if err != nil {
+			return err
+		}
+
+		// Register the client handler providing the CLI command to submit the proposal
+		template = `govProposalHandlers = append(govProposalHandlers, %[1]vmoduleclient.%[2]vProposalHandler)`
+		snippet := fmt.Sprintf(template, opts.ModuleName, opts.ProposalName.UpperCamel)
+		if strings.Count(content, module.PlaceholderSgAppGovProposalHandlers) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			snippet += "\n\t" + module.PlaceholderSgAppGovProposalHandlers
+			content = clip.Replace(content, module.PlaceholderSgAppGovProposalHandlers, snippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectBeforeCallingStatementPosition,
+				clipper.SelectOptions{
+					"functionName": "getGovProposalHandlers",
+					"callName":     "additionalGovProposalHandlers",
+				},
+				snippet+"\n\t",
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Route the proposals of the module once, the gov router is sealed when the gov keeper is created
+		handlerCall := fmt.Sprintf("%[1]vmodule.NewProposalHandler(", opts.ModuleName)
+		if !strings.Contains(content, handlerCall) {
+			template = `govRouter.AddRoute(%[1]vmoduletypes.RouterKey, %[2]v&app.%[3]vKeeper))
+
+	`
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectBeforeCallingStatementPosition,
+				clipper.SelectOptions{
+					"functionName": "New",
+					"callName":     "govkeeper.NewKeeper",
+				},
+				fmt.Sprintf(template, opts.ModuleName, handlerCall, strings.Title(opts.ModuleName)),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
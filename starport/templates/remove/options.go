@@ -0,0 +1,262 @@
+package remove
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+)
+
+// Kind is the kind of a scaffolded component
+type Kind string
+
+const (
+	KindList      Kind = "list"
+	KindMap       Kind = "map"
+	KindSingleton Kind = "singleton"
+	KindDry       Kind = "type"
+	KindMessage   Kind = "message"
+	KindQuery     Kind = "query"
+	KindPacket    Kind = "packet"
+	KindEvent     Kind = "event"
+	KindProposal  Kind = "proposal"
+)
+
+// Options ...
+type Options struct {
+	AppPath       string
+	ModuleName    string
+	ComponentName multiformatname.Name
+	Kind          Kind
+
+	// NoMessage is true if a type has been scaffolded without CRUD messages
+	NoMessage bool
+
+	// ListedBy are the names of the secondary indexes or index prefixes a list or a map can be listed by
+	ListedBy []multiformatname.Name
+}
+
+// isType returns true if the component is a type with a proto message of its own
+func (opts *Options) isType() bool {
+	switch opts.Kind {
+	case KindList, KindMap, KindSingleton, KindDry:
+		return true
+	}
+	return false
+}
+
+// hasMessages returns true if the component defines messages in tx.proto
+func (opts *Options) hasMessages() bool {
+	switch opts.Kind {
+	case KindList, KindMap, KindSingleton:
+		return !opts.NoMessage
+	case KindMessage, KindPacket:
+		return true
+	}
+	return false
+}
+
+// moduleFile returns the path of a file in the module directory
+func (opts *Options) moduleFile(elem ...string) string {
+	return filepath.Join(append([]string{opts.AppPath, "x", opts.ModuleName}, elem...)...)
+}
+
+// protoFile returns the path of a proto file of the module
+func (opts *Options) protoFile(name string) string {
+	return filepath.Join(opts.AppPath, "proto", opts.ModuleName, name)
+}
+
+// Files returns the existing files created for the component
+func Files(opts *Options) (files []string) {
+	name := opts.ComponentName.Snake
+
+	var candidates []string
+	switch opts.Kind {
+	case KindList, KindMap, KindSingleton, KindDry:
+		candidates = []string{
+			opts.protoFile(name + ".proto"),
+			opts.moduleFile("types", name+".pb.go"),
+		}
+		if opts.Kind == KindDry {
+			break
+		}
+		candidates = append(candidates,
+			opts.moduleFile("types", "key_"+name+".go"),
+			opts.moduleFile("client", "cli", "query_"+name+".go"),
+			opts.moduleFile("client", "cli", "query_"+name+"_test.go"),
+			opts.moduleFile("keeper", name+".go"),
+			opts.moduleFile("keeper", name+"_test.go"),
+			opts.moduleFile("keeper", "grpc_query_"+name+".go"),
+			opts.moduleFile("keeper", "grpc_query_"+name+"_test.go"),
+			opts.moduleFile("keeper", "invariant_"+name+".go"),
+			opts.moduleFile("keeper", "invariant_"+name+"_test.go"),
+		)
+		if len(opts.ListedBy) > 0 {
+			candidates = append(candidates,
+				opts.moduleFile("types", "key_"+name+"_index.go"),
+				opts.moduleFile("client", "cli", "query_"+name+"_index.go"),
+				opts.moduleFile("keeper", name+"_index.go"),
+				opts.moduleFile("keeper", name+"_index_test.go"),
+				opts.moduleFile("keeper", "grpc_query_"+name+"_index.go"),
+			)
+		}
+		if opts.NoMessage {
+			break
+		}
+		candidates = append(candidates,
+			opts.moduleFile("client", "cli", "tx_"+name+".go"),
+			opts.moduleFile("client", "cli", "tx_"+name+"_test.go"),
+			opts.moduleFile("keeper", "msg_server_"+name+".go"),
+			opts.moduleFile("keeper", "msg_server_"+name+"_test.go"),
+			opts.moduleFile("simulation", name+".go"),
+			opts.moduleFile("types", "messages_"+name+".go"),
+			opts.moduleFile("types", "messages_"+name+"_test.go"),
+			opts.moduleFile("client", "cli", "tx_"+name+"_ownership.go"),
+			opts.moduleFile("keeper", "msg_server_"+name+"_ownership.go"),
+			opts.moduleFile("keeper", "msg_server_"+name+"_ownership_test.go"),
+			opts.moduleFile("types", "messages_"+name+"_ownership.go"),
+			opts.moduleFile("types", "messages_"+name+"_ownership_test.go"),
+		)
+	case KindMessage:
+		candidates = []string{
+			opts.moduleFile("client", "cli", "tx_"+name+".go"),
+			opts.moduleFile("keeper", "msg_server_"+name+".go"),
+			opts.moduleFile("simulation", name+".go"),
+			opts.moduleFile("types", "message_"+name+".go"),
+			opts.moduleFile("types", "message_"+name+"_test.go"),
+		}
+	case KindQuery:
+		candidates = []string{
+			opts.moduleFile("client", "cli", "query_"+name+".go"),
+			opts.moduleFile("keeper", "grpc_query_"+name+".go"),
+		}
+	case KindPacket:
+		candidates = []string{
+			opts.moduleFile("keeper", name+".go"),
+			opts.moduleFile("keeper", name+"_test.go"),
+			opts.moduleFile("types", "packet_"+name+".go"),
+			opts.moduleFile("client", "cli", "tx_"+name+".go"),
+			opts.moduleFile("keeper", "msg_server_"+name+".go"),
+			opts.moduleFile("types", "messages_"+name+".go"),
+			opts.moduleFile("types", "messages_"+name+"_test.go"),
+		}
+	case KindEvent:
+		candidates = []string{
+			opts.moduleFile("keeper", "event_"+name+".go"),
+			opts.moduleFile("keeper", "event_"+name+"_test.go"),
+		}
+	case KindProposal:
+		candidates = []string{
+			opts.moduleFile("proposal_handler_" + name + "_test.go"),
+			opts.moduleFile("client", "proposal_"+name+".go"),
+			opts.moduleFile("client", "cli", "tx_proposal_"+name+".go"),
+			opts.moduleFile("client", "cli", "tx_proposal_"+name+"_test.go"),
+			opts.moduleFile("keeper", "proposal_"+name+".go"),
+			opts.moduleFile("types", "proposal_"+name+".go"),
+			opts.moduleFile("types", "proposal_"+name+"_test.go"),
+		}
+	}
+
+	for _, file := range candidates {
+		if _, err := os.Stat(file); err == nil {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// names returns the Go identifiers introduced by the component in the existing source code of the module
+func names(opts *Options) []string {
+	var (
+		upper   = opts.ComponentName.UpperCamel
+		lower   = opts.ComponentName.LowerCamel
+		results []string
+	)
+
+	switch opts.Kind {
+	case KindList:
+		results = []string{
+			upper + "List",
+			upper + "Count",
+			"Set" + upper,
+			"Set" + upper + "Count",
+			"GetAll" + upper,
+			"Get" + upper + "Count",
+			upper + "Key",
+			upper + "CountKey",
+			lower + "IdMap",
+			lower + "Count",
+			"CmdList" + upper,
+			"CmdShow" + upper,
+			upper + "CountInvariant",
+			upper + "IndexesInvariant",
+		}
+	case KindMap:
+		results = []string{
+			upper + "List",
+			"Set" + upper,
+			"GetAll" + upper,
+			lower + "IndexMap",
+			"CmdList" + upper,
+			"CmdShow" + upper,
+			upper + "KeysInvariant",
+			upper + "IndexesInvariant",
+		}
+	case KindSingleton:
+		results = []string{
+			upper,
+			"Set" + upper,
+			"Get" + upper,
+			upper + "Key",
+			"CmdShow" + upper,
+		}
+	case KindQuery:
+		results = []string{"Cmd" + upper}
+	case KindEvent:
+		results = []string{"Emit" + upper + "Event"}
+	case KindProposal:
+		results = []string{upper + "Proposal", upper + "ProposalHandler"}
+	case KindPacket:
+		results = []string{
+			strings.Title(opts.ModuleName) + "PacketData_" + upper + "Packet",
+			"EventType" + upper + "Packet",
+		}
+	}
+
+	for _, index := range opts.ListedBy {
+		results = append(results, "CmdList"+upper+"By"+index.UpperCamel)
+	}
+
+	for _, msg := range messages(opts) {
+		results = append(results,
+			msg,
+			"Cmd"+strings.TrimPrefix(msg, "Msg"),
+			"opWeight"+msg,
+			"defaultWeight"+msg,
+			"weight"+msg,
+		)
+	}
+	return results
+}
+
+// messages returns the names of the messages defined in tx.proto for the component
+func messages(opts *Options) []string {
+	if !opts.hasMessages() {
+		return nil
+	}
+
+	upper := opts.ComponentName.UpperCamel
+	switch opts.Kind {
+	case KindMessage:
+		return []string{"Msg" + upper}
+	case KindPacket:
+		return []string{"MsgSend" + upper}
+	}
+	return []string{
+		"MsgCreate" + upper,
+		"MsgUpdate" + upper,
+		"MsgDelete" + upper,
+		"MsgTransfer" + upper + "Ownership",
+	}
+}
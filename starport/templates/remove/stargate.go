@@ -0,0 +1,332 @@
+package remove
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+// cut is a selection of snippets to remove from a file
+type cut struct {
+	selector *clipper.RangeSelector
+	options  clipper.SelectOptions
+}
+
+// NewStargate returns the generator to remove a scaffolded component from a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	var (
+		g       = genny.New()
+		goNames = strings.Join(names(opts), ",")
+	)
+
+	// goCuts returns the cuts of the statements or elements referencing the component in the functions
+	goCuts := func(selector *clipper.RangeSelector, functionNames ...string) (cuts []cut) {
+		for _, functionName := range functionNames {
+			cuts = append(cuts, cut{
+				selector: selector,
+				options: clipper.SelectOptions{
+					"functionName": functionName,
+					"names":        goNames,
+				},
+			})
+		}
+		return cuts
+	}
+	declarationsCut := cut{
+		selector: clipper.GoSelectDeclarationsReferencing,
+		options:  clipper.SelectOptions{"names": goNames},
+	}
+	importsCut := cut{selector: clipper.GoSelectUnusedImports}
+
+	// Remove the snippets from the protobuf files
+	for path, cuts := range protoCuts(opts) {
+		modifyIfExists(g, path, cutModify(clip, path, cuts...))
+	}
+
+	// Remove the snippets from the Go files
+	for path, cuts := range map[string][]cut{
+		opts.moduleFile("genesis.go"): goCuts(clipper.GoSelectStatementsReferencing, "InitGenesis", "ExportGenesis"),
+		opts.moduleFile("module.go"):  goCuts(clipper.GoSelectStatementsReferencing, "RegisterInvariants"),
+		opts.moduleFile("genesis_test.go"): append(
+			goCuts(clipper.GoSelectCompositeElementsReferencing, "newTestGenesisState"),
+			goCuts(clipper.GoSelectStatementsReferencing, "TestGenesis")...,
+		),
+		opts.moduleFile("types", "genesis.go"): append(append(
+			goCuts(clipper.GoSelectCompositeElementsReferencing, "DefaultGenesis"),
+			goCuts(clipper.GoSelectStatementsReferencing, "Validate")...),
+			importsCut,
+		),
+		opts.moduleFile("types", "genesis_test.go"): goCuts(
+			clipper.GoSelectCompositeElementsReferencing,
+			"newTestGenesisState",
+			"TestGenesisState_Validate",
+		),
+		opts.moduleFile("types", "keys.go"):          {declarationsCut},
+		opts.moduleFile("types", "events_ibc.go"):    {declarationsCut},
+		opts.moduleFile("client", "cli", "query.go"): goCuts(clipper.GoSelectStatementsReferencing, "GetQueryCmd"),
+		opts.moduleFile("client", "cli", "tx.go"):    goCuts(clipper.GoSelectStatementsReferencing, "GetTxCmd"),
+		opts.moduleFile("types", "codec.go"): append(
+			goCuts(clipper.GoSelectStatementsReferencing, "RegisterCodec", "RegisterInterfaces"),
+			importsCut,
+		),
+		opts.moduleFile("module_simulation.go"): append(append(
+			[]cut{declarationsCut},
+			goCuts(clipper.GoSelectCompositeElementsReferencing, "newGenesisState", "GenerateGenesisState")...),
+			goCuts(clipper.GoSelectStatementsReferencing, "WeightedOperations")...,
+		),
+		opts.moduleFile("module_ibc.go"): goCuts(
+			clipper.GoSelectCaseClausesReferencing,
+			"OnRecvPacket",
+			"OnAcknowledgementPacket",
+			"OnTimeoutPacket",
+		),
+	} {
+		modifyIfExists(g, path, cutModify(clip, path, cuts...))
+	}
+
+	// Remove the client handler of a proposal from the app
+	appPath := filepath.Join(opts.AppPath, "app", "app.go")
+	if opts.Kind == KindProposal {
+		modifyIfExists(g, appPath, cutModify(clip, appPath, append(
+			goCuts(clipper.GoSelectStatementsReferencing, "getGovProposalHandlers"),
+			importsCut,
+		)...))
+	}
+
+	handlerPath := opts.moduleFile("handler.go")
+	modifyIfExists(g, handlerPath, handlerModify(clip, handlerPath, goCuts(
+		clipper.GoSelectCaseClausesReferencing,
+		"NewHandler",
+	)...))
+
+	proposalHandlerPath := opts.moduleFile("proposal_handler.go")
+	modifyIfExists(g, proposalHandlerPath, cutModify(clip, proposalHandlerPath, goCuts(
+		clipper.GoSelectCaseClausesReferencing,
+		"NewProposalHandler",
+	)...))
+
+	if opts.isType() {
+		vuePath := filepath.Join(opts.AppPath, "vue/src/views/Types.vue")
+		modifyIfExists(g, vuePath, frontendSrcStoreAppModify(opts, vuePath))
+	}
+
+	// Remove the files created for the component
+	for _, file := range Files(opts) {
+		g.RunFn(deleteFile(file))
+	}
+
+	return g, nil
+}
+
+// protoCuts returns the cuts to perform in each protobuf file of the module
+func protoCuts(opts *Options) map[string][]cut {
+	var (
+		upper = opts.ComponentName.UpperCamel
+		lower = opts.ComponentName.LowerCamel
+		cuts  = make(map[string][]cut)
+	)
+
+	// joinedCut returns a cut with the values of an option joined
+	joinedCut := func(selector *clipper.RangeSelector, options clipper.SelectOptions, key string, values ...string) cut {
+		options[key] = strings.Join(values, ",")
+		return cut{selector: selector, options: options}
+	}
+
+	queryPath := opts.protoFile("query.proto")
+	switch opts.Kind {
+	case KindList, KindMap:
+		methods := []string{upper, upper + "All"}
+		msgs := []string{
+			"QueryGet" + upper + "Request", "QueryGet" + upper + "Response",
+			"QueryAll" + upper + "Request", "QueryAll" + upper + "Response",
+		}
+		for _, index := range opts.ListedBy {
+			byIndex := upper + "By" + index.UpperCamel
+			methods = append(methods, "List"+byIndex)
+			msgs = append(msgs, "QueryList"+byIndex+"Request", "QueryList"+byIndex+"Response")
+		}
+		cuts[queryPath] = []cut{
+			joinedCut(clipper.ProtoSelectServiceMethods, clipper.SelectOptions{"name": "Query"}, "methods", methods...),
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names", msgs...),
+		}
+	case KindSingleton:
+		cuts[queryPath] = []cut{
+			joinedCut(clipper.ProtoSelectServiceMethods, clipper.SelectOptions{"name": "Query"}, "methods", upper),
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names",
+				"QueryGet"+upper+"Request", "QueryGet"+upper+"Response"),
+		}
+	case KindQuery:
+		cuts[queryPath] = []cut{
+			joinedCut(clipper.ProtoSelectServiceMethods, clipper.SelectOptions{"name": "Query"}, "methods", upper),
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names",
+				"Query"+upper+"Request", "Query"+upper+"Response"),
+		}
+	case KindPacket:
+		packetPath := opts.protoFile("packet.proto")
+		cuts[packetPath] = []cut{
+			joinedCut(clipper.ProtoSelectMessageFields, clipper.SelectOptions{
+				"name": fmt.Sprintf("%vPacketData", strings.Title(opts.ModuleName)),
+			}, "fields", lower+"Packet"),
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names",
+				upper+"PacketData", upper+"PacketAck"),
+		}
+	}
+
+	if opts.hasMessages() {
+		var methods, msgs []string
+		for _, msg := range messages(opts) {
+			methods = append(methods, strings.TrimPrefix(msg, "Msg"))
+			msgs = append(msgs, msg, msg+"Response")
+		}
+
+		txPath := opts.protoFile("tx.proto")
+		cuts[txPath] = []cut{
+			joinedCut(clipper.ProtoSelectServiceMethods, clipper.SelectOptions{"name": "Msg"}, "methods", methods...),
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names", msgs...),
+		}
+	}
+
+	// The events emitted by the messages or defined by the component
+	eventsPath := opts.protoFile("events.proto")
+	switch {
+	case opts.Kind == KindMessage, opts.Kind == KindEvent:
+		cuts[eventsPath] = []cut{
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names", "Event"+upper),
+		}
+	case opts.isType() && opts.hasMessages():
+		cuts[eventsPath] = []cut{
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names",
+				"Event"+upper+"Created", "Event"+upper+"Updated", "Event"+upper+"Deleted"),
+		}
+	}
+
+	proposalPath := opts.protoFile("proposal.proto")
+	if opts.Kind == KindProposal {
+		cuts[proposalPath] = []cut{
+			joinedCut(clipper.ProtoSelectMessages, clipper.SelectOptions{}, "names", upper+"Proposal"),
+		}
+	}
+
+	if opts.isType() {
+		genesisPath := opts.protoFile("genesis.proto")
+		cuts[genesisPath] = []cut{
+			joinedCut(clipper.ProtoSelectMessageFields, clipper.SelectOptions{"name": "GenesisState"}, "fields",
+				lower+"List", lower+"Count", lower),
+		}
+
+		// The type is imported in each protobuf file using it
+		importCut := joinedCut(clipper.ProtoSelectImports, clipper.SelectOptions{}, "paths",
+			fmt.Sprintf("%s/%s.proto", opts.ModuleName, opts.ComponentName.Snake))
+		for _, path := range []string{queryPath, genesisPath, opts.protoFile("tx.proto"), eventsPath, proposalPath} {
+			cuts[path] = append(cuts[path], importCut)
+		}
+	}
+
+	return cuts
+}
+
+// modifyIfExists adds the modification of a file to the generator only if the file exists
+func modifyIfExists(g *genny.Generator, path string, fn genny.RunFn) {
+	if _, err := os.Stat(path); err == nil {
+		g.RunFn(fn)
+	}
+}
+
+// cutModify removes the snippets selected by the cuts from a file
+func cutModify(clip *clipper.Clipper, path string, cuts ...cut) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, c := range cuts {
+			content, err = clip.CutCodeSnippetsAt(path, content, c.selector, c.options)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Files without anything to remove are left untouched
+		if content == f.String() {
+			return nil
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func handlerModify(clip *clipper.Clipper, path string, cuts ...cut) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, c := range cuts {
+			content, err = clip.CutCodeSnippetsAt(path, content, c.selector, c.options)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Unset the MsgServer definition if it is not used anymore
+		if content != f.String() && !strings.Contains(content, "msgServer.") {
+			content = strings.Replace(
+				content,
+				"msgServer := keeper.NewMsgServerImpl(k)",
+				typed.PlaceholderHandlerMsgServer,
+				1,
+			)
+		}
+
+		if content == f.String() {
+			return nil
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func frontendSrcStoreAppModify(opts *Options, path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var (
+			lines    = strings.SplitAfter(f.String(), "\n")
+			typeAttr = fmt.Sprintf(`moduleType="%s"`, opts.ComponentName.UpperCamel)
+			content  strings.Builder
+		)
+		for _, line := range lines {
+			if strings.Contains(line, "<SpType") && strings.Contains(line, typeAttr) {
+				continue
+			}
+			content.WriteString(line)
+		}
+
+		if content.String() == f.String() {
+			return nil
+		}
+
+		newFile := genny.NewFileS(path, content.String())
+		return r.File(newFile)
+	}
+}
+
+func deleteFile(path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		return r.Disk.Delete(path)
+	}
+}
@@ -0,0 +1,65 @@
+package typed
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+)
+
+// EventsProtoModify returns the function adding to events.proto the events emitted
+// when an element of the type is created, updated or deleted
+func EventsProtoModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "events.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import the type
+		templateImport := `
+import "%s";`
+		importSnippet := fmt.Sprintf(templateImport, opts.ProtoImport())
+		content := strings.ReplaceAll(f.String(), importSnippet, "")
+		content, err = clip.PasteProtoImportSnippetAt(path, content, importSnippet)
+		if err != nil {
+			return err
+		}
+
+		// Add the events
+		templateEvents := `
+
+message Event%[1]vCreated {
+  %[1]v %[2]v = 1 [(gogoproto.nullable) = false];
+}
+
+message Event%[1]vUpdated {
+  %[1]v %[2]v = 1 [(gogoproto.nullable) = false];
+}
+
+message Event%[1]vDeleted {
+  %[1]v %[2]v = 1 [(gogoproto.nullable) = false];
+}`
+		eventsSnippet := fmt.Sprintf(
+			templateEvents,
+			opts.TypeName.UpperCamel,
+			opts.TypeName.LowerCamel,
+		)
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectLastPosition,
+			nil,
+			eventsSnippet,
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
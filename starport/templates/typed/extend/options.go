@@ -0,0 +1,30 @@
+package extend
+
+import (
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/templates/field"
+)
+
+// Options are the options to add fields to a type
+type Options struct {
+	AppPath    string
+	ModulePath string
+	ModuleName string
+	TypeName   multiformatname.Name
+	Fields     field.Fields
+
+	// NoMessage is true if the type has been scaffolded without CRUD messages
+	NoMessage bool
+}
+
+// moduleFile returns the path of a file of the module
+func (opts *Options) moduleFile(elem ...string) string {
+	return filepath.Join(append([]string{opts.AppPath, "x", opts.ModuleName}, elem...)...)
+}
+
+// protoFile returns the path of a proto file of the module
+func (opts *Options) protoFile(name string) string {
+	return filepath.Join(opts.AppPath, "proto", opts.ModuleName, name)
+}
@@ -0,0 +1,594 @@
+// Package extend provides the generator to add fields to a type already scaffolded in a module.
+package extend
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"math/rand"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+// exactArgs matches the number of arguments expected by a command
+var exactArgs = regexp.MustCompile(`^cobra\.ExactArgs\(([0-9]+)\)$`)
+
+// sampleSignerRe matches the signer of the messages initialized with a sample address in the tests
+var sampleSignerRe = regexp.MustCompile(`(\w+):\s+sample\.AccAddress\(\)`)
+
+// NewStargate returns the generator to add fields to a type scaffolded in a Stargate module
+func NewStargate(clip *clipper.Clipper, opts *Options) *genny.Generator {
+	g := genny.New()
+
+	typeName := opts.TypeName.Snake + ".proto"
+	g.RunFn(protoModify(clip, opts, opts.protoFile(typeName), opts.TypeName.UpperCamel))
+
+	if !opts.NoMessage {
+		g.RunFn(protoModify(
+			clip,
+			opts,
+			opts.protoFile("tx.proto"),
+			"MsgCreate"+opts.TypeName.UpperCamel,
+			"MsgUpdate"+opts.TypeName.UpperCamel,
+		))
+		g.RunFn(typesMessagesModify(clip, opts))
+		g.RunFn(keeperMsgServerModify(clip, opts))
+		g.RunFn(clientCliTxModify(clip, opts))
+
+		// The tests are not generated for every type
+		path := opts.moduleFile("types", "messages_"+opts.TypeName.Snake+"_test.go")
+		if _, err := os.Stat(path); err == nil {
+			g.RunFn(typesMessagesTestModify(clip, opts, path))
+		}
+		path = opts.moduleFile("client", "cli", "tx_"+opts.TypeName.Snake+"_test.go")
+		if _, err := os.Stat(path); err == nil {
+			g.RunFn(clientCliTxTestModify(clip, opts, path))
+		}
+		path = opts.moduleFile("simulation", opts.TypeName.Snake+".go")
+		if _, err := os.Stat(path); err == nil {
+			g.RunFn(simulationModify(clip, opts, path))
+		}
+	}
+
+	// The genesis of the lists and maps only sets their keys, only the values of the singletons in the genesis tests
+	// have the fields
+	for _, path := range []string{
+		opts.moduleFile("genesis_test.go"),
+		opts.moduleFile("types", "genesis_test.go"),
+	} {
+		if _, err := os.Stat(path); err == nil {
+			g.RunFn(genesisTestsModify(clip, opts, path))
+		}
+	}
+
+	return g
+}
+
+// protoModify adds the fields to the messages of a proto file
+func protoModify(clip *clipper.Clipper, opts *Options, path string, messageNames ...string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		// Ensure custom types and types from dependencies are imported
+		protoImports := opts.Fields.ProtoImports()
+		for _, f := range opts.Fields.Custom() {
+			protoImports = append(protoImports,
+				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
+			)
+		}
+		for _, f := range protoImports {
+			if strings.Contains(content, fmt.Sprintf(`import "%[1]v";`, f)) {
+				continue
+			}
+
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, f)
+			content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, messageName := range messageNames {
+			content, err = clip.PasteGeneratedCodeSnippetAt(
+				path,
+				content,
+				clipper.ProtoSelectNewMessageFieldPosition,
+				clipper.SelectOptions{
+					"name": messageName,
+				},
+				func(data interface{}) string {
+					highestNumber := data.(clipper.ProtoNewMessageFieldPositionData).HighestFieldNumber
+
+					var fields string
+					for i, field := range opts.Fields {
+						fields += fmt.Sprintf("  %s;\n", field.ProtoType(int(highestNumber)+i+1))
+					}
+					return fields
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// typesMessagesModify adds the fields to the constructors of the messages
+func typesMessagesModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.moduleFile("types", "messages_"+opts.TypeName.Snake+".go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, functionName := range []string{
+			"NewMsgCreate" + opts.TypeName.UpperCamel,
+			"NewMsgUpdate" + opts.TypeName.UpperCamel,
+		} {
+			options := clipper.SelectOptions{
+				"functionName": functionName,
+			}
+			for _, field := range opts.Fields {
+				content, err = clip.PasteGoFunctionNewParameterSnippetAt(
+					path,
+					content,
+					fmt.Sprintf("%s %s", field.Name.LowerCamel, field.DataType()),
+					options,
+				)
+				if err != nil {
+					return err
+				}
+
+				content, err = clip.PasteGoReturningCompositeNewArgumentSnippetAt(
+					path,
+					content,
+					fmt.Sprintf("%s: %s", field.Name.UpperCamel, field.Name.LowerCamel),
+					options,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Validate the values of the fields that need it
+		for _, messageName := range []string{
+			"MsgCreate" + opts.TypeName.UpperCamel,
+			"MsgUpdate" + opts.TypeName.UpperCamel,
+		} {
+			for _, field := range opts.Fields {
+				validation := field.ValidateBasic(opts.TypeName.LowerCamel, "msg.")
+				if validation == "" {
+					continue
+				}
+
+				content, err = clip.PasteGoBeforeReturnSnippetAt(
+					path,
+					content,
+					validation,
+					clipper.SelectOptions{
+						"functionName": "ValidateBasic",
+						"receiverType": messageName,
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Declare the variables used by the validations, like the compiled regular expressions
+		if vars := opts.Fields.ValidationVars(opts.TypeName.LowerCamel); vars != "" {
+			content, err = clip.PasteCodeSnippetAt(path, content, clipper.GoSelectNewGlobalPosition, nil, "\n"+vars+"\n")
+			if err != nil {
+				return err
+			}
+		}
+
+		// Import the packages required by the types of the fields
+		for _, goImport := range opts.Fields.GoTypeImports() {
+			content, err = pasteGoImport(clip, path, content, goImport)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// typesMessagesTestModify adds valid values of the fields that are validated to the valid messages of the tests
+// and a test case for each of the constraints of the fields
+func typesMessagesTestModify(clip *clipper.Clipper, opts *Options, path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		var elements string
+		for _, field := range opts.Fields {
+			if value := field.SampleTestValue(); value != "" {
+				elements += fmt.Sprintf("%s: %s,\n", field.Name.UpperCamel, value)
+			}
+		}
+		if elements == "" {
+			return nil
+		}
+
+		// The invalid values are tested with a valid signer
+		signer := "Creator"
+		if match := sampleSignerRe.FindStringSubmatch(content); match != nil {
+			signer = match[1]
+		}
+
+		for _, action := range []string{"Create", "Update"} {
+			functionName := "TestMsg" + action + opts.TypeName.UpperCamel + "_ValidateBasic"
+			content, err = clip.ReplaceCodeSnippetsAt(
+				path,
+				content,
+				clipper.GoSelectKeyValueElementValues,
+				clipper.SelectOptions{
+					"functionName": functionName,
+					"key":          "msg",
+				},
+				func(data interface{}) string {
+					snippet := data.(string)
+					closing := strings.LastIndex(snippet, "}")
+					if closing < 0 {
+						return snippet
+					}
+					items := strings.TrimSpace(snippet[:closing])
+					if !strings.HasSuffix(items, "{") && !strings.HasSuffix(items, ",") {
+						items += ","
+					}
+					return fmt.Sprintf("%s\n%s}", items, elements)
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			// Test each constraint of the fields with a value not satisfying it
+			for _, field := range opts.Fields {
+				for _, invalid := range field.InvalidTestValues() {
+					values := fmt.Sprintf("%s: sample.AccAddress(),\n", signer)
+					for _, f := range opts.Fields {
+						switch value := f.SampleTestValue(); {
+						case f.Name.LowerCamel == field.Name.LowerCamel:
+							values += fmt.Sprintf("%s: %s,\n", f.Name.UpperCamel, invalid.Code)
+						case value != "":
+							values += fmt.Sprintf("%s: %s,\n", f.Name.UpperCamel, value)
+						}
+					}
+					testCase := fmt.Sprintf(`{
+	name: "invalid %[1]v %[2]v",
+	msg: Msg%[3]v%[4]v{
+		%[5]v},
+	err: sdkerrors.ErrInvalidRequest,
+}`, field.Name.LowerCamel, invalid.Constraint, action, opts.TypeName.UpperCamel, values)
+
+					content, err = clip.PasteGoAssignedCompositeNewElementSnippetAt(
+						path,
+						content,
+						testCase,
+						clipper.SelectOptions{
+							"functionName": functionName,
+							"variableName": "tests",
+						},
+					)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperMsgServerModify adds the fields to the values stored by the message server
+func keeperMsgServerModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.moduleFile("keeper", "msg_server_"+opts.TypeName.Snake+".go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, functionName := range []string{
+			"Create" + opts.TypeName.UpperCamel,
+			"Update" + opts.TypeName.UpperCamel,
+		} {
+			for _, field := range opts.Fields {
+				content, err = clip.PasteGoAssignedCompositeNewElementSnippetAt(
+					path,
+					content,
+					fmt.Sprintf("%[1]v: msg.%[1]v", field.Name.UpperCamel),
+					clipper.SelectOptions{
+						"functionName": functionName,
+						"variableName": opts.TypeName.LowerCamel,
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// clientCliTxModify adds the fields as arguments of the commands creating and updating the type
+func clientCliTxModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.moduleFile("client", "cli", "tx_"+opts.TypeName.Snake+".go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, action := range []string{"Create", "Update"} {
+			functionName := "Cmd" + action + opts.TypeName.UpperCamel
+			options := func(key, value string) clipper.SelectOptions {
+				return clipper.SelectOptions{
+					"functionName": functionName,
+					key:            value,
+				}
+			}
+
+			// The fields are parsed from the arguments following the existing ones
+			argsCount := -1
+			content, err = clip.ReplaceCodeSnippetsAt(
+				path,
+				content,
+				clipper.GoSelectKeyValueElementValues,
+				options("key", "Args"),
+				func(data interface{}) string {
+					snippet := data.(string)
+					match := exactArgs.FindStringSubmatch(snippet)
+					if match == nil {
+						return snippet
+					}
+					argsCount, _ = strconv.Atoi(match[1])
+					return fmt.Sprintf("cobra.ExactArgs(%d)", argsCount+len(opts.Fields))
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if argsCount < 0 {
+				return fmt.Errorf("cannot find the number of arguments of %s in %s", functionName, path)
+			}
+
+			content, err = clip.ReplaceCodeSnippetsAt(
+				path,
+				content,
+				clipper.GoSelectKeyValueElementValues,
+				options("key", "Use"),
+				func(data interface{}) string {
+					snippet := data.(string)
+					use, err := strconv.Unquote(snippet)
+					if err != nil {
+						return snippet
+					}
+					return strconv.Quote(use + opts.Fields.String())
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			var parseArgs string
+			for i, field := range opts.Fields {
+				parseArgs += fmt.Sprintf("%s\n", field.CLIArgs("arg", argsCount+i))
+			}
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectBeforeCallingStatementPosition,
+				options("callName", "client.GetClientTxContext"),
+				parseArgs+"\n",
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, field := range opts.Fields {
+				content, err = clip.PasteGoFunctionCallNewArgumentSnippetAt(
+					path,
+					content,
+					"arg"+field.Name.UpperCamel,
+					options("callName", "types.NewMsg"+action+opts.TypeName.UpperCamel),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Import the packages required to parse the arguments
+		for _, goImport := range opts.Fields.GoCLIImports() {
+			content, err = pasteGoImport(clip, path, content, goImport)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// clientCliTxTestModify adds the default values of the fields to the arguments of the commands in the tests
+func clientCliTxTestModify(clip *clipper.Clipper, opts *Options, path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, action := range []string{"Create", "Update", "Delete"} {
+			for _, field := range opts.Fields {
+				content, err = clip.PasteGoAssignedCompositeNewElementSnippetAt(
+					path,
+					content,
+					strconv.Quote(field.DefaultTestValue()),
+					clipper.SelectOptions{
+						"functionName": "Test" + action + opts.TypeName.UpperCamel,
+						"variableName": "fields",
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// simulationModify adds the values of the fields that are not valid with their zero value to the messages created
+// and updated by the simulation, the updated messages get their values once the simulated account is found
+func simulationModify(clip *clipper.Clipper, opts *Options, path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		var assignments string
+		for _, field := range opts.Fields {
+			value := field.SimulationValue()
+			if value == "" {
+				continue
+			}
+			content, err = clip.PasteGoAssignedCompositeNewElementSnippetAt(
+				path,
+				content,
+				fmt.Sprintf("%s: %s", field.Name.UpperCamel, value),
+				clipper.SelectOptions{
+					"functionName": "SimulateMsgCreate" + opts.TypeName.UpperCamel,
+					"variableName": "msg",
+				},
+			)
+			if err != nil {
+				return err
+			}
+			assignments += fmt.Sprintf("msg.%s = %s\n", field.Name.UpperCamel, value)
+		}
+		if assignments == "" {
+			return nil
+		}
+
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectBeforeCallingStatementPosition,
+			clipper.SelectOptions{
+				"functionName": "SimulateMsgUpdate" + opts.TypeName.UpperCamel,
+				"callName":     "msg.Type",
+			},
+			assignments+"\n",
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// genesisTestsModify adds the sample values of the fields to the singleton values of the genesis tests
+func genesisTestsModify(clip *clipper.Clipper, opts *Options, path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var elements string
+		for _, field := range opts.Fields {
+			elements += field.GenesisArgs(rand.Intn(100) + 1)
+		}
+		if elements == "" {
+			return nil
+		}
+
+		value := fmt.Sprintf("%[1]v: &types.%[1]v{", opts.TypeName.UpperCamel)
+		content := strings.ReplaceAll(f.String(), value, value+"\n"+strings.TrimSuffix(elements, "\n"))
+
+		if opts.Fields.GenesisArgsSample() {
+			sampleImport := datatype.GoImport{Name: opts.ModulePath + "/testutil/sample"}
+			content, err = pasteGoImport(clip, path, content, sampleImport)
+			if err != nil {
+				return err
+			}
+		}
+		for _, goImport := range opts.Fields.GenesisArgsImports() {
+			content, err = pasteGoImport(clip, path, content, goImport)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// pasteGoImport imports a package in the Go code if it is not already imported
+func pasteGoImport(clip *clipper.Clipper, path, code string, goImport datatype.GoImport) (string, error) {
+	imported, err := goImported(path, code, goImport.Name)
+	if err != nil || imported {
+		return code, err
+	}
+	return clip.PasteGoImportSnippetAt(
+		path,
+		code,
+		strings.TrimSpace(fmt.Sprintf("%s %q", goImport.Alias, goImport.Name)),
+	)
+}
+
+// goImported checks if a package is imported in the Go code
+func goImported(path, code, importPath string) (bool, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, code, parser.ImportsOnly)
+	if err != nil {
+		return false, err
+	}
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err == nil && p == importPath {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,78 @@
+// Package invariant provides the templates to check the consistency of the store of a list or a map with invariants
+package invariant
+
+import (
+	"embed"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/invariant"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+var (
+	//go:embed stargate/component/* stargate/component/**/*
+	fsStargateComponent embed.FS
+
+	//go:embed stargate/tests/* stargate/tests/**/*
+	fsStargateTests embed.FS
+)
+
+// Register adds to the generator of a list or a map the invariants of the type
+// the invariants are registered by the module and checked by the crisis module of the app
+func Register(clip *clipper.Clipper, opts *typed.Options, g *genny.Generator, withTests bool) error {
+	if !opts.Invariants {
+		return nil
+	}
+
+	names, err := invariantNames(opts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		componentTemplate = xgenny.NewEmbedWalker(
+			fsStargateComponent,
+			"stargate/component/",
+			opts.AppPath,
+		)
+		testsTemplate = xgenny.NewEmbedWalker(
+			fsStargateTests,
+			"stargate/tests/",
+			opts.AppPath,
+		)
+	)
+
+	g.RunFn(invariant.ModuleModify(clip, opts.AppPath, opts.ModuleName, names...))
+
+	if withTests {
+		if err := typed.Box(testsTemplate, opts, g); err != nil {
+			return err
+		}
+	}
+	return typed.Box(componentTemplate, opts, g)
+}
+
+// invariantNames returns the names of the invariants of a list or a map
+// a list checks its count, a map checks the keys of its values and both check their secondary indexes
+func invariantNames(opts *typed.Options) ([]multiformatname.Name, error) {
+	suffixes := []string{"count"}
+	if len(opts.Indexes) > 0 {
+		suffixes = []string{"keys"}
+	}
+	if len(opts.SecondaryIndexes) > 0 {
+		suffixes = append(suffixes, "indexes")
+	}
+
+	var names []multiformatname.Name
+	for _, suffix := range suffixes {
+		name, err := multiformatname.NewName(opts.TypeName.LowerCamel + "-" + suffix)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
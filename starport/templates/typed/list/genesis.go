@@ -32,12 +32,8 @@ import "gogoproto/gogo.proto";`, "")
 
 		templateProtoImport := `
 import "gogoproto/gogo.proto";
-import "%[1]v/%[2]v.proto";`
-		importString := fmt.Sprintf(
-			templateProtoImport,
-			opts.ModuleName,
-			opts.TypeName.Snake,
-		)
+import "%s";`
+		importString := fmt.Sprintf(templateProtoImport, opts.ProtoImport())
 
 		content, err = clip.PasteProtoImportSnippetAt(path, content, importString)
 		if err != nil {
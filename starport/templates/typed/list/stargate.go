@@ -11,6 +11,8 @@ import (
 	"github.com/tendermint/starport/starport/pkg/clipper"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/templates/typed"
+	"github.com/tendermint/starport/starport/templates/typed/invariant"
+	"github.com/tendermint/starport/starport/templates/typed/secondaryindex"
 )
 
 var (
@@ -53,6 +55,7 @@ func NewStargate(clip *clipper.Clipper, opts *typed.Options) (*genny.Generator,
 		g.RunFn(typesCodecModify(clip, opts))
 		g.RunFn(clientCliTxModify(clip, opts))
 		g.RunFn(moduleSimulationModify(clip, opts))
+		g.RunFn(typed.EventsProtoModify(clip, opts))
 
 		// Messages template
 		if err := typed.Box(messagesTemplate, opts, g); err != nil {
@@ -62,6 +65,16 @@ func NewStargate(clip *clipper.Clipper, opts *typed.Options) (*genny.Generator,
 
 	g.RunFn(frontendSrcStoreAppModify(clip, opts))
 
+	// Secondary indexes of the type
+	if err := secondaryindex.Register(clip, opts, g, true); err != nil {
+		return nil, err
+	}
+
+	// Invariants of the type
+	if err := invariant.Register(clip, opts, g, true); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
@@ -110,11 +123,8 @@ func protoTxModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
 
 		// Import
 		templateImport := `
-import "%s/%s.proto";`
-		importString := fmt.Sprintf(templateImport,
-			opts.ModuleName,
-			opts.TypeName.Snake,
-		)
+import "%s";`
+		importString := fmt.Sprintf(templateImport, opts.ProtoImport())
 
 		content, err := clip.PasteProtoImportSnippetAt(path, f.String(), importString)
 		if err != nil {
@@ -235,11 +245,8 @@ import "gogoproto/gogo.proto";`, "")
 		// Import
 		templateImport := `
 import "gogoproto/gogo.proto";
-import "%s/%s.proto";`
-		importString := fmt.Sprintf(templateImport,
-			opts.ModuleName,
-			opts.TypeName.Snake,
-		)
+import "%s";`
+		importString := fmt.Sprintf(templateImport, opts.ProtoImport())
 
 		content, err = clip.PasteProtoImportSnippetAt(path, content, importString)
 		if err != nil {
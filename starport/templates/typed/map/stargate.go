@@ -12,6 +12,8 @@ import (
 	"github.com/tendermint/starport/starport/templates/field/datatype"
 	"github.com/tendermint/starport/starport/templates/module"
 	"github.com/tendermint/starport/starport/templates/typed"
+	"github.com/tendermint/starport/starport/templates/typed/invariant"
+	"github.com/tendermint/starport/starport/templates/typed/secondaryindex"
 )
 
 var (
@@ -80,6 +82,7 @@ func NewStargate(clip *clipper.Clipper, opts *typed.Options) (*genny.Generator,
 		g.RunFn(clientCliTxModify(clip, opts))
 		g.RunFn(typesCodecModify(clip, opts))
 		g.RunFn(moduleSimulationModify(clip, opts))
+		g.RunFn(typed.EventsProtoModify(clip, opts))
 
 		if err := typed.Box(messagesTemplate, opts, g); err != nil {
 			return nil, err
@@ -96,6 +99,17 @@ func NewStargate(clip *clipper.Clipper, opts *typed.Options) (*genny.Generator,
 			return nil, err
 		}
 	}
+
+	// Secondary indexes of the type
+	if err := secondaryindex.Register(clip, opts, g, generateTest); err != nil {
+		return nil, err
+	}
+
+	// Invariants of the type
+	if err := invariant.Register(clip, opts, g, generateTest); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
@@ -113,11 +127,8 @@ import "gogoproto/gogo.proto";`, "")
 		// Import the type
 		templateImport := `
 import "gogoproto/gogo.proto";
-import "%s/%s.proto";`
-		importString := fmt.Sprintf(templateImport,
-			opts.ModuleName,
-			opts.TypeName.Snake,
-		)
+import "%s";`
+		importString := fmt.Sprintf(templateImport, opts.ProtoImport())
 
 		content, err = clip.PasteProtoImportSnippetAt(path, content, importString)
 		if err != nil {
@@ -151,6 +162,26 @@ import "%s/%s.proto";`
 			indexPath,
 		)
 
+		// Add the services listing the values from a prefix of the index
+		templateServicePrefix := `
+	// Queries a list of %[2]v items by %[6]v.
+	rpc List%[1]vBy%[7]v(QueryList%[1]vBy%[7]vRequest) returns (QueryList%[1]vBy%[7]vResponse) {
+		option (google.api.http).get = "/%[3]v/%[4]v/%[5]v/%[2]vBy%[7]v/%[8]v";
+	}
+`
+		for _, prefix := range opts.IndexPrefixes() {
+			replacementService += fmt.Sprintf(templateServicePrefix,
+				opts.TypeName.UpperCamel,
+				opts.TypeName.LowerCamel,
+				opts.OwnerName,
+				opts.AppName,
+				opts.ModuleName,
+				strings.Join(strings.Split(prefix.Name.Kebab, "-"), " "),
+				prefix.Name.UpperCamel,
+				strings.Join(lowerCamelIndexes[:len(prefix.Indexes)], "/"),
+			)
+		}
+
 		if strings.Count(content, typed.Placeholder2) != 0 {
 			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
 			replacementService += typed.Placeholder2
@@ -178,8 +209,9 @@ import "%s/%s.proto";`
 		}
 
 		// Ensure custom types are imported
-		protoImports := opts.Fields.ProtoImports()
-		for _, f := range opts.Fields.Custom() {
+		protoImports := append(opts.Fields.ProtoImports(), opts.Indexes.ProtoImports()...)
+		customFields := append(opts.Fields.Custom(), opts.Indexes.Custom()...)
+		for _, f := range customFields {
 			protoImports = append(protoImports,
 				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
 			)
@@ -218,6 +250,32 @@ message QueryAll%[1]vResponse {
 			opts.TypeName.LowerCamel,
 			queryIndexFields,
 		)
+
+		templateMessagePrefix := `
+
+message QueryList%[1]vBy%[3]vRequest {
+	%[4]v
+	cosmos.base.query.v1beta1.PageRequest pagination = %[5]v;
+}
+
+message QueryList%[1]vBy%[3]vResponse {
+	repeated %[1]v %[2]v = 1 [(gogoproto.nullable) = false];
+	cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}`
+		for _, prefix := range opts.IndexPrefixes() {
+			var prefixIndexFields string
+			for i, index := range prefix.Indexes {
+				prefixIndexFields += fmt.Sprintf("  %s;\n", index.ProtoType(i+1))
+			}
+			replacementMessage += fmt.Sprintf(templateMessagePrefix,
+				opts.TypeName.UpperCamel,
+				opts.TypeName.LowerCamel,
+				prefix.Name.UpperCamel,
+				prefixIndexFields,
+				len(prefix.Indexes)+1,
+			)
+		}
+
 		content, err = clip.PasteCodeSnippetAt(
 			path,
 			content,
@@ -270,6 +328,12 @@ func clientCliQueryModify(clip *clipper.Clipper, opts *typed.Options) genny.RunF
 		snippet := fmt.Sprintf(template,
 			opts.TypeName.UpperCamel,
 		)
+		for _, prefix := range opts.IndexPrefixes() {
+			snippet += fmt.Sprintf("\n\tcmd.AddCommand(CmdList%[1]vBy%[2]v())",
+				opts.TypeName.UpperCamel,
+				prefix.Name.UpperCamel,
+			)
+		}
 
 		if strings.Count(content, typed.Placeholder) != 0 {
 			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
@@ -303,12 +367,8 @@ import "gogoproto/gogo.proto";`, "")
 
 		templateProtoImport := `
 import "gogoproto/gogo.proto";
-import "%[1]v/%[2]v.proto";`
-		importString := fmt.Sprintf(
-			templateProtoImport,
-			opts.ModuleName,
-			opts.TypeName.Snake,
-		)
+import "%s";`
+		importString := fmt.Sprintf(templateProtoImport, opts.ProtoImport())
 
 		content, err = clip.PasteProtoImportSnippetAt(path, content, importString)
 		if err != nil {
@@ -652,11 +712,8 @@ func protoTxModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
 
 		// Import
 		templateImport := `
-import "%s/%s.proto";`
-		importString := fmt.Sprintf(templateImport,
-			opts.ModuleName,
-			opts.TypeName.Snake,
-		)
+import "%s";`
+		importString := fmt.Sprintf(templateImport, opts.ProtoImport())
 
 		content, err := clip.PasteProtoImportSnippetAt(path, f.String(), importString)
 		if err != nil {
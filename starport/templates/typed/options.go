@@ -1,10 +1,25 @@
 package typed
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/templates/field"
 )
 
+// Access controls of the messages updating and deleting the values of a type
+const (
+	// AccessOwner only allows the owner of a value to update, delete and transfer it
+	AccessOwner = "owner"
+
+	// AccessAdmin also allows the admins of the module to update, delete and transfer the values
+	AccessAdmin = "admin"
+
+	// AccessOpen allows any account to update and delete the values
+	AccessOpen = "open"
+)
+
 // Options ...
 type Options struct {
 	AppName    string
@@ -18,9 +33,57 @@ type Options struct {
 	Indexes    field.Fields
 	NoMessage  bool
 	IsIBC      bool
+
+	// SecondaryIndexes are the fields of the type the values can be queried by
+	SecondaryIndexes field.Fields
+
+	// Invariants makes the module register invariants checking the consistency of the store of the type
+	Invariants bool
+
+	// Access is the access control of the messages updating and deleting the values of the type
+	Access string
+
+	// ProtoFile is the existing proto file defining the message of the type, relative to the proto directory
+	// the message is generated in <module>/<type>.proto if it is empty
+	ProtoFile string
 }
 
 // Validate that options are usuable
 func (opts *Options) Validate() error {
 	return nil
 }
+
+// ProtoImport returns the import path of the proto file defining the message of the type
+func (opts *Options) ProtoImport() string {
+	if opts.ProtoFile != "" {
+		return opts.ProtoFile
+	}
+	return fmt.Sprintf("%s/%s.proto", opts.ModuleName, opts.TypeName.Snake)
+}
+
+// IndexPrefix is a prefix of the index of a map made of its first index fields
+type IndexPrefix struct {
+	Name    multiformatname.Name
+	Indexes field.Fields
+}
+
+// IndexPrefixes returns the prefixes of the index of a map the values can be listed by
+// they go from the first index field alone to all the index fields but the last one
+func (opts *Options) IndexPrefixes() []IndexPrefix {
+	var (
+		prefixes []IndexPrefix
+		names    []string
+	)
+	for i := 0; i < len(opts.Indexes)-1; i++ {
+		names = append(names, opts.Indexes[i].Name.LowerCamel)
+		name, err := multiformatname.NewName(strings.Join(names, "-and-"))
+		if err != nil {
+			panic(fmt.Sprintf("invalid index prefix name: %s", err.Error()))
+		}
+		prefixes = append(prefixes, IndexPrefix{
+			Name:    name,
+			Indexes: opts.Indexes[:i+1],
+		})
+	}
+	return prefixes
+}
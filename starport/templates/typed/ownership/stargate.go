@@ -0,0 +1,281 @@
+// Package ownership provides the templates to transfer the ownership of the values of a list, a map or a singleton
+package ownership
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+var (
+	//go:embed stargate/messages/* stargate/messages/**/*
+	fsStargateMessages embed.FS
+
+	//go:embed stargate/tests/* stargate/tests/**/*
+	fsStargateTests embed.FS
+)
+
+// NewStargate returns the generator to scaffold the message transferring the ownership of the values of a type
+// the keys are the fields identifying a value: the id of a list, the indexes of a map and none for a singleton
+func NewStargate(clip *clipper.Clipper, opts *typed.Options, keys field.Fields) (*genny.Generator, error) {
+	// Tests are not generated for keys that contain only booleans
+	// because we can't generate reliable tests for them
+	withTests := len(keys) == 0
+	for _, key := range keys {
+		if key.DatatypeName != datatype.Bool {
+			withTests = true
+		}
+	}
+
+	var (
+		g = genny.New()
+
+		messagesTemplate = xgenny.NewEmbedWalker(
+			fsStargateMessages,
+			"stargate/messages/",
+			opts.AppPath,
+		)
+		testsTemplate = xgenny.NewEmbedWalker(
+			fsStargateTests,
+			"stargate/tests/",
+			opts.AppPath,
+		)
+	)
+
+	g.RunFn(protoTxModify(clip, opts, keys))
+	g.RunFn(handlerModify(clip, opts))
+	g.RunFn(typesCodecModify(clip, opts))
+	g.RunFn(clientCliTxModify(clip, opts))
+
+	if err := g.Box(messagesTemplate); err != nil {
+		return nil, err
+	}
+	if withTests {
+		if err := g.Box(testsTemplate); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("TypeName", opts.TypeName)
+	ctx.Set("MsgSigner", opts.MsgSigner)
+	ctx.Set("Indexes", opts.Indexes)
+	ctx.Set("Keys", keys)
+	ctx.Set("Access", opts.Access)
+	plushhelpers.ExtendPlushContext(ctx)
+
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{typeName}}", opts.TypeName.Snake))
+
+	return g, nil
+}
+
+func protoTxModify(clip *clipper.Clipper, opts *typed.Options, keys field.Fields) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "tx.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// RPC service
+		replacementRPC := fmt.Sprintf(
+			"  rpc Transfer%[1]vOwnership(MsgTransfer%[1]vOwnership) returns (MsgTransfer%[1]vOwnershipResponse);\n",
+			opts.TypeName.UpperCamel,
+		)
+
+		content := f.String()
+		if strings.Count(content, typed.PlaceholderProtoTxRPC) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			replacementRPC += typed.PlaceholderProtoTxRPC
+			content = clip.Replace(content, typed.PlaceholderProtoTxRPC, replacementRPC)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.ProtoSelectNewServiceMethodPosition,
+				clipper.SelectOptions{
+					"name": "Msg",
+				},
+				replacementRPC,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Messages
+		var keyFields string
+		for i, key := range keys {
+			keyFields += fmt.Sprintf("  %s;\n", key.ProtoType(i+2))
+		}
+		templateMessages := `
+
+message MsgTransfer%[1]vOwnership {
+  string %[2]v = 1;
+%[3]v  string newOwner = %[4]v;
+}
+
+message MsgTransfer%[1]vOwnershipResponse {}`
+		replacementMessages := fmt.Sprintf(templateMessages,
+			opts.TypeName.UpperCamel,
+			opts.MsgSigner.LowerCamel,
+			keyFields,
+			len(keys)+2,
+		)
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.ProtoSelectLastPosition,
+			nil,
+			replacementMessages,
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func handlerModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "handler.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateHandlers := `case *types.MsgTransfer%[2]vOwnership:
+					res, err := msgServer.Transfer%[2]vOwnership(sdk.WrapSDKContext(ctx), msg)
+					return sdk.WrapServiceResult(ctx, res, err)
+%[1]v`
+		replacementHandlers := fmt.Sprintf(templateHandlers,
+			typed.Placeholder,
+			opts.TypeName.UpperCamel,
+		)
+		content := clip.Replace(f.String(), typed.Placeholder, replacementHandlers)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesCodecModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/codec.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Concrete
+		startOfFunctionSnippet := fmt.Sprintf(`
+	cdc.RegisterConcrete(&MsgTransfer%[1]vOwnership{}, "%[2]v/Transfer%[1]vOwnership", nil)`,
+			opts.TypeName.UpperCamel,
+			opts.ModuleName,
+		)
+
+		content := f.String()
+		if strings.Count(content, typed.Placeholder2) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			startOfFunctionSnippet += "\n" + typed.Placeholder2
+			content = clip.Replace(content, typed.Placeholder2, startOfFunctionSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectStartOfFunctionPosition,
+				clipper.SelectOptions{
+					"functionName": "RegisterCodec",
+				},
+				startOfFunctionSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Interface
+		startOfFunctionSnippet = fmt.Sprintf(`
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransfer%[1]vOwnership{},
+	)`,
+			opts.TypeName.UpperCamel,
+		)
+
+		if strings.Count(content, typed.Placeholder3) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			startOfFunctionSnippet += "\n" + typed.Placeholder3
+			content = clip.Replace(content, typed.Placeholder3, startOfFunctionSnippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.GoSelectStartOfFunctionPosition,
+				clipper.SelectOptions{
+					"functionName": "RegisterInterfaces",
+				},
+				startOfFunctionSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliTxModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/tx.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		snippet := fmt.Sprintf(`cmd.AddCommand(CmdTransfer%[1]vOwnership())`, opts.TypeName.UpperCamel)
+
+		if strings.Count(content, typed.Placeholder) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			snippet += "\n" + typed.Placeholder
+			content = clip.Replace(content, typed.Placeholder, snippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(
+				path,
+				content,
+				snippet,
+				clipper.SelectOptions{
+					"functionName": "GetTxCmd",
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
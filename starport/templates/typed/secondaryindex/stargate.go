@@ -0,0 +1,188 @@
+// Package secondaryindex provides the templates to index the values of a list or a map by some of their fields
+package secondaryindex
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+var (
+	//go:embed stargate/component/* stargate/component/**/*
+	fsStargateComponent embed.FS
+
+	//go:embed stargate/tests/* stargate/tests/**/*
+	fsStargateTests embed.FS
+)
+
+// Register adds to the generator of a list or a map the secondary indexes of the type
+// the keeper of the type is expected to keep the indexes in sync when the values are set or removed
+func Register(clip *clipper.Clipper, opts *typed.Options, g *genny.Generator, withTests bool) error {
+	if len(opts.SecondaryIndexes) == 0 {
+		return nil
+	}
+
+	var (
+		componentTemplate = xgenny.NewEmbedWalker(
+			fsStargateComponent,
+			"stargate/component/",
+			opts.AppPath,
+		)
+		testsTemplate = xgenny.NewEmbedWalker(
+			fsStargateTests,
+			"stargate/tests/",
+			opts.AppPath,
+		)
+	)
+
+	g.RunFn(protoQueryModify(clip, opts))
+	g.RunFn(clientCliQueryModify(clip, opts))
+
+	if withTests {
+		if err := typed.Box(testsTemplate, opts, g); err != nil {
+			return err
+		}
+	}
+	return typed.Box(componentTemplate, opts, g)
+}
+
+func protoQueryModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		// Ensure the enums used as secondary indexes are imported
+		protoImports := opts.SecondaryIndexes.ProtoImports()
+		for _, f := range opts.SecondaryIndexes.Custom() {
+			protoImports = append(protoImports,
+				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
+			)
+		}
+		for _, f := range protoImports {
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, f)
+			content = strings.ReplaceAll(content, importModule, "")
+
+			content, err = clip.PasteProtoImportSnippetAt(path, content, importModule)
+			if err != nil {
+				return err
+			}
+		}
+
+		templateRPC := `
+	// Queries a list of %[2]v items by %[7]v.
+	rpc List%[1]vBy%[6]v(QueryList%[1]vBy%[6]vRequest) returns (QueryList%[1]vBy%[6]vResponse) {
+		option (google.api.http).get = "/%[3]v/%[4]v/%[5]v/%[2]vBy%[6]v/{%[7]v}";
+	}
+`
+		templateMessages := `
+
+message QueryList%[1]vBy%[3]vRequest {
+	%[4]v;
+	cosmos.base.query.v1beta1.PageRequest pagination = 2;
+}
+
+message QueryList%[1]vBy%[3]vResponse {
+	repeated %[1]v %[2]v = 1 [(gogoproto.nullable) = false];
+	cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}`
+		for _, index := range opts.SecondaryIndexes {
+			serviceSnippet := fmt.Sprintf(templateRPC,
+				opts.TypeName.UpperCamel,
+				opts.TypeName.LowerCamel,
+				opts.OwnerName,
+				opts.AppName,
+				opts.ModuleName,
+				index.Name.UpperCamel,
+				index.Name.LowerCamel,
+			)
+
+			if strings.Count(content, typed.Placeholder2) != 0 {
+				// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+				serviceSnippet += typed.Placeholder2
+				content = clip.Replace(content, typed.Placeholder2, serviceSnippet)
+			} else {
+				// And for newer codebase, we use clipper mechanism.
+				content, err = clip.PasteCodeSnippetAt(
+					path,
+					content,
+					clipper.ProtoSelectNewServiceMethodPosition,
+					clipper.SelectOptions{
+						"name": "Query",
+					},
+					serviceSnippet,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			messagesSnippet := fmt.Sprintf(templateMessages,
+				opts.TypeName.UpperCamel,
+				opts.TypeName.LowerCamel,
+				index.Name.UpperCamel,
+				index.ProtoType(1),
+			)
+			content, err = clip.PasteCodeSnippetAt(
+				path,
+				content,
+				clipper.ProtoSelectLastPosition,
+				nil,
+				messagesSnippet,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliQueryModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var commands []string
+		for _, index := range opts.SecondaryIndexes {
+			commands = append(commands, fmt.Sprintf("cmd.AddCommand(CmdList%[1]vBy%[2]v())",
+				opts.TypeName.UpperCamel,
+				index.Name.UpperCamel,
+			))
+		}
+		snippet := strings.Join(commands, "\n\t")
+		content := f.String()
+
+		if strings.Count(content, typed.Placeholder) != 0 {
+			// To make code generation backwards compatible, we use placeholder mechanism if the code already uses it.
+			snippet += "\n" + typed.Placeholder
+			content = clip.Replace(content, typed.Placeholder, snippet)
+		} else {
+			// And for newer codebase, we use clipper mechanism.
+			content, err = clip.PasteGoBeforeReturnSnippetAt(path, content, snippet, clipper.SelectOptions{
+				"functionName": "GetQueryCmd",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
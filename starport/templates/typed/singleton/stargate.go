@@ -56,6 +56,7 @@ func NewStargate(clip *clipper.Clipper, opts *typed.Options) (*genny.Generator,
 		g.RunFn(clientCliTxModify(clip, opts))
 		g.RunFn(typesCodecModify(clip, opts))
 		g.RunFn(moduleSimulationModify(clip, opts))
+		g.RunFn(typed.EventsProtoModify(clip, opts))
 
 		if err := typed.Box(messagesTemplate, opts, g); err != nil {
 			return nil, err
@@ -376,6 +377,11 @@ func genesisTestsModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn
 			}
 		}
 
+		content, err = pasteGenesisArgsImports(clip, opts, path, content)
+		if err != nil {
+			return err
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -422,6 +428,11 @@ func genesisTypesTestsModify(clip *clipper.Clipper, opts *typed.Options) genny.R
 			}
 		}
 
+		content, err = pasteGenesisArgsImports(clip, opts, path, content)
+		if err != nil {
+			return err
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -758,3 +769,30 @@ func typesCodecModify(clip *clipper.Clipper, opts *typed.Options) genny.RunFn {
 		return r.File(newFile)
 	}
 }
+
+// pasteGenesisArgsImports imports the sample package of the app and the packages used by the sample values of the
+// fields in a genesis test
+func pasteGenesisArgsImports(clip *clipper.Clipper, opts *typed.Options, path, content string) (string, error) {
+	var err error
+	importPath := fmt.Sprintf("%s/testutil/sample", opts.ModulePath)
+	if opts.Fields.GenesisArgsSample() && !strings.Contains(content, fmt.Sprintf("%q", importPath)) {
+		content, err = clip.PasteGoImportSnippetAt(path, content, fmt.Sprintf("%q", importPath))
+		if err != nil {
+			return content, err
+		}
+	}
+	for _, goImport := range opts.Fields.GenesisArgsImports() {
+		if strings.Contains(content, fmt.Sprintf("%q", goImport.Name)) {
+			continue
+		}
+		content, err = clip.PasteGoImportSnippetAt(
+			path,
+			content,
+			strings.TrimSpace(fmt.Sprintf("%s %q", goImport.Alias, goImport.Name)),
+		)
+		if err != nil {
+			return content, err
+		}
+	}
+	return content, nil
+}
@@ -1,6 +1,8 @@
 package typed
 
 import (
+	"path/filepath"
+
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/packd"
 	"github.com/gobuffalo/plush"
@@ -11,6 +13,10 @@ import (
 )
 
 func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
+	// The message of a type scaffolded from an existing proto file is not generated again
+	if opts.ProtoFile != "" {
+		box = withoutProtoFiles{box}
+	}
 	if err := g.Box(box); err != nil {
 		return err
 	}
@@ -23,7 +29,10 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("MsgSigner", opts.MsgSigner)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("Indexes", opts.Indexes)
+	ctx.Set("SecondaryIndexes", opts.SecondaryIndexes)
+	ctx.Set("IndexPrefixes", opts.IndexPrefixes())
 	ctx.Set("NoMessage", opts.NoMessage)
+	ctx.Set("Access", opts.Access)
 	ctx.Set("strconv", func() bool {
 		strconv := false
 		for _, field := range opts.Fields {
@@ -49,3 +58,18 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 
 	return nil
 }
+
+// withoutProtoFiles walks the templates except the proto file defining the message of the type
+type withoutProtoFiles struct {
+	packd.Walker
+}
+
+// Walk implements packd.Walker.
+func (w withoutProtoFiles) Walk(wl packd.WalkFunc) error {
+	return w.Walker.Walk(func(path string, f packd.File) error {
+		if filepath.Base(path) == "{{typeName}}.proto.plush" {
+			return nil
+		}
+		return wl(path, f)
+	})
+}
@@ -0,0 +1,14 @@
+package upgrade
+
+// Options ...
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModulePath string
+
+	// UpgradeName is the name of the upgrade plan, e.g. "v1.1"
+	UpgradeName string
+
+	// UpgradePackage is the name of the package of the upgrade, e.g. "v1_1"
+	UpgradePackage string
+}
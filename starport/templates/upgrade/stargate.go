@@ -0,0 +1,141 @@
+package upgrade
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/clipper"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+const funcSetupUpgradeHandlers = "setupUpgradeHandlers"
+
+// NewStargate returns the generator to scaffold a software upgrade handler in a Stargate app
+func NewStargate(clip *clipper.Clipper, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(appModify(clip, opts))
+
+	template := xgenny.NewEmbedWalker(
+		fsStargate,
+		"stargate/",
+		opts.AppPath,
+	)
+	return g, Box(template, opts, g)
+}
+
+// app.go modification to register the upgrade handler
+func appModify(clip *clipper.Clipper, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if !strings.Contains(content, "func (app *App) "+funcSetupUpgradeHandlers+"()") {
+			content, err = appSupportUpgrades(clip, path, content)
+			if err != nil {
+				return err
+			}
+		}
+
+		template := `"%[1]v/app/upgrades/%[2]v"`
+		importSnippet := fmt.Sprintf(template, opts.ModulePath, opts.UpgradePackage)
+		content, err = clip.PasteGoImportSnippetAt(path, content, importSnippet)
+		if err != nil {
+			return err
+		}
+
+		template = `app.UpgradeKeeper.SetUpgradeHandler(%[1]v.UpgradeName, %[1]v.CreateUpgradeHandler(app.mm, app.configurator))`
+		content, err = clip.PasteGoBeforeReturnSnippetAt(
+			path,
+			content,
+			fmt.Sprintf(template, opts.UpgradePackage),
+			clipper.SelectOptions{
+				"functionName": funcSetupUpgradeHandlers,
+				"receiverType": "App",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appSupportUpgrades keeps the configurator of the modules in the app and adds the method setting up the upgrade
+// handlers before the stores are loaded
+func appSupportUpgrades(clip *clipper.Clipper, path, content string) (string, error) {
+	var err error
+	if !strings.Contains(content, "app.configurator") {
+		content, err = clip.PasteCodeSnippetAt(
+			path,
+			content,
+			clipper.GoSelectStructNewFieldPosition,
+			clipper.SelectOptions{
+				"structName": "App",
+			},
+			`
+	// configurator registers the services and the store migrations of the modules
+	configurator module.Configurator
+`,
+		)
+		if err != nil {
+			return "", err
+		}
+
+		content, err = clip.ReplaceCodeSnippetsAt(
+			path,
+			content,
+			clipper.GoSelectStatementsReferencing,
+			clipper.SelectOptions{
+				"functionName": "New",
+				"names":        "RegisterServices",
+			},
+			func(data interface{}) string {
+				statement := data.(string)
+				start := strings.Index(statement, "RegisterServices(")
+				end := strings.LastIndex(statement, ")")
+				if start < 0 || end < start {
+					return statement
+				}
+				configurator := statement[start+len("RegisterServices(") : end]
+				return fmt.Sprintf(`app.configurator = %v
+	app.mm.RegisterServices(app.configurator)`, configurator)
+			},
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// The upgrade handlers are set up before the stores are loaded
+	content, err = clip.ReplaceCodeSnippetsAt(
+		path,
+		content,
+		clipper.GoSelectStatementsReferencing,
+		clipper.SelectOptions{
+			"functionName": "New",
+			"names":        "LoadLatestVersion",
+		},
+		func(data interface{}) string {
+			return fmt.Sprintf("app.%v()\n\n\t%v", funcSetupUpgradeHandlers, data.(string))
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return content + fmt.Sprintf(`
+// %[1]v registers the handlers of the software upgrades of the app
+func (app *App) %[1]v() {
+}
+`, funcSetupUpgradeHandlers), nil
+}
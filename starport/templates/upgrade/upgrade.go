@@ -0,0 +1,33 @@
+package upgrade
+
+import (
+	"embed"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/packd"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+)
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+)
+
+func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
+	if err := g.Box(box); err != nil {
+		return err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("UpgradeName", opts.UpgradeName)
+	ctx.Set("UpgradePackage", opts.UpgradePackage)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{upgradePackage}}", opts.UpgradePackage))
+
+	return nil
+}